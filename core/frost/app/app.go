@@ -11,6 +11,8 @@ import (
 	hc "github.com/ra341/glacier/frost/http_client"
 	ll "github.com/ra341/glacier/frost/local_library"
 	"github.com/ra341/glacier/frost/local_library/download"
+	"github.com/ra341/glacier/frost/local_library/install"
+	"github.com/ra341/glacier/frost/local_library/runner"
 	"github.com/ra341/glacier/frost/secrets"
 	"github.com/ra341/glacier/pkg/logger"
 	"github.com/rs/zerolog/log"
@@ -47,20 +49,39 @@ func New() *App {
 
 	frostProtectedBase := get.Server.GlacierUrl + "/api/server/protected"
 
+	schedule, err := download.ParseSchedule(get.Downloader.SpeedSchedule)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid speed schedule")
+	}
+	throttle := download.NewThrottle(download.Bandwidth{
+		Limit:     int64(get.Downloader.SpeedLimit) * download.KB,
+		GameLimit: int64(get.Downloader.GameSpeedLimit) * download.KB,
+		Schedule:  schedule,
+	})
+
 	llStore := ll.NewStoreGorm(db)
 	downloader := download.New(
 		frostProtectedBase,
 		httpCliFac,
 		llStore,
+		throttle,
+		get.Downloader.MaxConcurrentGames,
 		get.Downloader.MaxConcurrentFiles,
 		get.Downloader.MaxFileChunks,
+		get.Downloader.MaxRetries,
 	)
 
+	installer := install.New(filepath.Join(abs, "logs"), llStore)
+	runners := runner.New(filepath.Join(abs, "prefixes"))
+
 	llibSrv := ll.New(
 		frostProtectedBase,
 		llStore,
 		downloader,
+		installer,
+		runners,
 		httpCliFac,
+		get.Play.SyncPlaytime,
 	)
 
 	a := &App{
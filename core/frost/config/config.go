@@ -5,6 +5,7 @@ type Config struct {
 	Files      Files
 	Logger     Logger
 	Downloader Downloader
+	Play       Play
 }
 
 type Server struct {
@@ -15,8 +16,18 @@ type Server struct {
 }
 
 type Downloader struct {
+	MaxConcurrentGames int `yaml:"maxConcurrentGames" env:"MAX_GAMES" default:"2" help:"Maximum number of games downloading at once, the rest are queued, 0 for no limit"`
 	MaxConcurrentFiles int `yaml:"maxConcurrentFiles" env:"MAX_FILES" default:"50" help:"Maximum number of concurrent files"`
 	MaxFileChunks      int `yaml:"maxFileChunks" env:"MAX_CHUNKS" default:"100" help:"Maximum number of chunks in a file to process"`
+	MaxRetries         int `yaml:"maxRetries" env:"MAX_RETRIES" default:"20" help:"Retries shared by all chunks of a game before its download fails"`
+
+	SpeedLimit     int    `yaml:"speedLimit" env:"SPEED_LIMIT" default:"0" help:"Download speed limit shared by all games in KB/s, 0 for no limit"`
+	GameSpeedLimit int    `yaml:"gameSpeedLimit" env:"GAME_SPEED_LIMIT" default:"0" help:"Download speed limit of each game in KB/s, 0 for no limit"`
+	SpeedSchedule  string `yaml:"speedSchedule" env:"SPEED_SCHEDULE" default:"none" help:"Daily windows overriding speedLimit, e.g. 00:00-07:00=0,07:00-00:00=5120 in KB/s"`
+}
+
+type Play struct {
+	SyncPlaytime bool `yaml:"syncPlaytime" env:"SYNC_PLAYTIME" default:"true" help:"Report play sessions to glacier for the logged in user"`
 }
 
 type Files struct {
@@ -0,0 +1,27 @@
+-- +goose Up
+-- add column "extracted" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `extracted` integer NULL;
+-- add column "extract_total" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `extract_total` integer NULL;
+-- add column "source_name" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `source_name` text NULL;
+-- add column "uris" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `uris` text NULL;
+-- add column "seeders" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `seeders` integer NULL;
+-- add column "priority" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `priority` integer NULL;
+
+-- +goose Down
+-- reverse: add column "priority" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `priority`;
+-- reverse: add column "seeders" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `seeders`;
+-- reverse: add column "uris" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `uris`;
+-- reverse: add column "source_name" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `source_name`;
+-- reverse: add column "extract_total" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `extract_total`;
+-- reverse: add column "extracted" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `extracted`;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- add column "install_state" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `install_state` text NULL;
+-- add column "install_path" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `install_path` text NULL;
+-- add column "install_message" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `install_message` text NULL;
+-- add column "installed_at" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `installed_at` datetime NULL;
+
+-- +goose Down
+-- reverse: add column "installed_at" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `installed_at`;
+-- reverse: add column "install_message" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `install_message`;
+-- reverse: add column "install_path" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `install_path`;
+-- reverse: add column "install_state" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `install_state`;
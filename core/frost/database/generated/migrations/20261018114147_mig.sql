@@ -0,0 +1,35 @@
+-- +goose Up
+-- add column "args" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `args` text NULL;
+-- add column "env" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `env` text NULL;
+-- add column "work_dir" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `work_dir` text NULL;
+-- add column "playtime_seconds" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `playtime_seconds` integer NULL;
+-- add column "last_played" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `last_played` datetime NULL;
+-- create "play_sessions" table
+CREATE TABLE `play_sessions` (`id` integer NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NULL, `updated_at` datetime NULL, `deleted_at` datetime NULL, `game_id` integer NULL, `started` datetime NULL, `ended` datetime NULL, `seconds` integer NULL, `exit_code` integer NULL);
+-- create index "idx_play_sessions_game_id" to table: "play_sessions"
+CREATE INDEX `idx_play_sessions_game_id` ON `play_sessions` (`game_id`);
+-- create index "idx_play_sessions_deleted_at" to table: "play_sessions"
+CREATE INDEX `idx_play_sessions_deleted_at` ON `play_sessions` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_play_sessions_deleted_at" to table: "play_sessions"
+DROP INDEX `idx_play_sessions_deleted_at`;
+-- reverse: create index "idx_play_sessions_game_id" to table: "play_sessions"
+DROP INDEX `idx_play_sessions_game_id`;
+-- reverse: create "play_sessions" table
+DROP TABLE `play_sessions`;
+-- reverse: add column "last_played" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `last_played`;
+-- reverse: add column "playtime_seconds" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `playtime_seconds`;
+-- reverse: add column "work_dir" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `work_dir`;
+-- reverse: add column "env" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `env`;
+-- reverse: add column "args" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `args`;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- add column "runner_kind" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `runner_kind` text NULL;
+-- add column "runner_path" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `runner_path` text NULL;
+-- add column "prefix_path" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `prefix_path` text NULL;
+
+-- +goose Down
+-- reverse: add column "prefix_path" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `prefix_path`;
+-- reverse: add column "runner_path" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `runner_path`;
+-- reverse: add column "runner_kind" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `runner_kind`;
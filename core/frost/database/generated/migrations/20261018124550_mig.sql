@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "visibility" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `visibility` text NULL;
+
+-- +goose Down
+-- reverse: add column "visibility" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `visibility`;
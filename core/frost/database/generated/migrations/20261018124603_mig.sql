@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "requested_by" to table: "local_games"
+ALTER TABLE `local_games` ADD COLUMN `requested_by` integer NULL;
+-- create index "idx_local_games_requested_by" to table: "local_games"
+CREATE INDEX `idx_local_games_requested_by` ON `local_games` (`requested_by`);
+
+-- +goose Down
+-- reverse: create index "idx_local_games_requested_by" to table: "local_games"
+DROP INDEX `idx_local_games_requested_by`;
+-- reverse: add column "requested_by" to table: "local_games"
+ALTER TABLE `local_games` DROP COLUMN `requested_by`;
@@ -0,0 +1,162 @@
+package download
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ra341/glacier/internal/library"
+	"github.com/ra341/glacier/pkg/fileutil"
+
+	"github.com/rs/zerolog/log"
+)
+
+// delta is the difference between the local files and the server manifest
+type delta struct {
+	// a previous download exists in the folder
+	update bool
+
+	download []fileTask
+	// full paths of files no longer on the server
+	removed   []string
+	unchanged int
+}
+
+type fileTask struct {
+	fm library.FileManifest
+	// chunks must be recreated, the file is new or changed on the server
+	fresh bool
+	// only with fresh, chunks of blocks matching the local file are not downloaded
+	patch bool
+}
+
+// diff compares the cached state of each file with the manifest checksums
+func (d *Download) diff(meta *library.FolderManifest) (*delta, error) {
+	cached, err := d.cacheStore.GetFileList()
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &delta{update: len(cached) > 0}
+	upstream := make(map[string]struct{}, len(meta.FileInfo))
+
+	for _, fm := range meta.FileInfo {
+		fullPath := filepath.Join(d.downloadFolder, fm.RelPath)
+		upstream[fullPath] = struct{}{}
+
+		task, skip, err := d.diffFile(fm, fullPath)
+		if err != nil {
+			return nil, err
+		}
+		if skip {
+			dt.unchanged++
+			continue
+		}
+		dt.download = append(dt.download, task)
+	}
+
+	for _, file := range cached {
+		if _, ok := upstream[file]; !ok {
+			dt.removed = append(dt.removed, file)
+		}
+	}
+
+	return dt, nil
+}
+
+// diffFile decides if the file can be skipped, resumed, patched or has to be downloaded again
+func (d *Download) diffFile(fm library.FileManifest, fullPath string) (task fileTask, skip bool, err error) {
+	task = fileTask{fm: fm, fresh: true}
+
+	checksum, found, err := d.cacheStore.GetChecksum(fullPath)
+	if err != nil || !found {
+		return task, false, err
+	}
+
+	stat, err := os.Stat(fullPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return task, false, nil
+	}
+	if err != nil {
+		return task, false, err
+	}
+
+	// if the file changed on the server, reuse the blocks that still match
+	task.patch = len(fm.Blocks) > 0
+	if stat.Size() != fm.Size {
+		return task, false, nil
+	}
+
+	chunks, _, err := d.cacheStore.Get(fullPath)
+	if err != nil {
+		return task, false, err
+	}
+	complete := allComplete(chunks)
+
+	if checksum == "" {
+		// cached by an older version, only a finished file can be checked
+		if !complete {
+			return task, false, nil
+		}
+
+		hash, err := library.GetHash(fullPath)
+		if err != nil {
+			return task, false, err
+		}
+		if hash != fm.Checksum {
+			return task, false, nil
+		}
+
+		return task, true, d.cacheStore.SetChecksum(fullPath, hash)
+	}
+
+	if checksum != fm.Checksum {
+		return task, false, nil
+	}
+
+	// same file as the server, resume the missing chunks
+	task.fresh = false
+	task.patch = false
+	return task, complete, nil
+}
+
+func allComplete(chunks []Chunk) bool {
+	for _, c := range chunks {
+		if c.State != ChunkComplete {
+			return false
+		}
+	}
+	return true
+}
+
+// removeFiles deletes files that were removed on the server
+func (d *Download) removeFiles(files []string) error {
+	for _, file := range files {
+		err := fileutil.RemoveAllWithin(d.downloadFolder, file)
+		if err != nil {
+			return err
+		}
+
+		err = d.cacheStore.Remove(file)
+		if err != nil {
+			return err
+		}
+
+		d.removeEmptyParents(filepath.Dir(file))
+		log.Debug().Str("file", file).Msg("removed file deleted on the server")
+	}
+
+	return nil
+}
+
+// removeEmptyParents removes empty directories left behind up to the download folder
+func (d *Download) removeEmptyParents(dir string) {
+	root := filepath.Clean(d.downloadFolder)
+	for dir = filepath.Clean(dir); dir != root && len(dir) > len(root); dir = filepath.Dir(dir) {
+		// fails on non-empty dirs
+		if os.Remove(dir) != nil {
+			return
+		}
+	}
+}
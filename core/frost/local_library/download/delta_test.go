@@ -0,0 +1,255 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/ra341/glacier/internal/library"
+	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/ra341/glacier/pkg/pubsub"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeServer struct {
+	mu      sync.Mutex
+	files   map[string][]byte
+	modTime time.Time
+	// rel paths of the files downloaded
+	fetched map[string]bool
+	// requested ranges per rel path
+	ranges map[string][]string
+	// serve manifests without block hashes like older servers
+	noBlocks bool
+	// serve gob manifests without a content type like older servers
+	legacy bool
+	// lets a test break a file request, returns false to serve it normally
+	intercept func(w http.ResponseWriter, rel string) bool
+	// called without the lock before a file request is served
+	hold func(r *http.Request)
+}
+
+const testBlockSize = 4
+
+func testBlocks(contents []byte) []string {
+	var blocks []string
+	for start := 0; start < len(contents); start += testBlockSize {
+		end := min(start+testBlockSize, len(contents))
+		blocks = append(blocks, strconv.FormatUint(xxhash.Sum64(contents[start:end]), 10))
+	}
+	return blocks
+}
+
+func newFakeServer(t *testing.T, files map[string]string) (*httptest.Server, *fakeServer) {
+	fs := &fakeServer{}
+	fs.set(files)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fs.mu.Lock()
+
+		if strings.HasPrefix(r.URL.Path, "/meta/") {
+			defer fs.mu.Unlock()
+
+			meta := library.FolderManifest{}
+			for rel, contents := range fs.files {
+				fm := library.FileManifest{
+					RelPath:  rel,
+					Size:     int64(len(contents)),
+					ModTime:  fs.modTime,
+					Checksum: strconv.FormatUint(xxhash.Sum64(contents), 10),
+				}
+				if !fs.noBlocks {
+					fm.BlockSize = testBlockSize
+					fm.Blocks = testBlocks(contents)
+				}
+				meta.FileInfo = append(meta.FileInfo, fm)
+			}
+			contentType := library.NegotiateManifest(r.Header.Get("Accept"))
+			if fs.legacy {
+				contentType = library.ContentTypeGob
+			} else {
+				w.Header().Set("Content-Type", contentType)
+			}
+			_ = library.EncodeManifest(w, contentType, &meta)
+			return
+		}
+
+		// every game is served the same files
+		_, gamePath, _ := strings.Cut(strings.TrimPrefix(r.URL.EscapedPath(), "/load/"), "/")
+		rel, _ := url.PathUnescape(gamePath)
+		contents, ok := fs.files[rel]
+		if !ok {
+			fs.mu.Unlock()
+			http.NotFound(w, r)
+			return
+		}
+		fs.fetched[rel] = true
+		fs.ranges[rel] = append(fs.ranges[rel], r.Header.Get("Range"))
+		if fs.intercept != nil && fs.intercept(w, rel) {
+			fs.mu.Unlock()
+			return
+		}
+		hold, modTime := fs.hold, fs.modTime
+		fs.mu.Unlock()
+
+		if hold != nil {
+			hold(r)
+		}
+		http.ServeContent(w, r, rel, modTime, bytes.NewReader(contents))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, fs
+}
+
+func (fs *fakeServer) set(files map[string]string) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.files = map[string][]byte{}
+	for rel, contents := range files {
+		fs.files[rel] = []byte(contents)
+	}
+	fs.modTime = time.Now().Add(time.Hour).Truncate(time.Second)
+	fs.fetched = map[string]bool{}
+	fs.ranges = map[string][]string{}
+}
+
+type testConfig struct{}
+
+func (testConfig) getMaxConcurrentFiles() int               { return 4 }
+func (testConfig) getChunkSize() int64                      { return 4 }
+func (testConfig) getMaxConcurrentFileChunks() int          { return 4 }
+func (testConfig) getHttpClient() *http.Client              { return http.DefaultClient }
+func (testConfig) getThrottle() *Throttle                   { return nil }
+func (testConfig) getEvents() *pubsub.Broker[ProgressEvent] { return nil }
+func (testConfig) getSpace() *fileutil.SpaceReserver        { return nil }
+func (testConfig) getRetryPolicy() RetryPolicy {
+	return RetryPolicy{Budget: 3, BaseWait: time.Millisecond, MaxWait: 5 * time.Millisecond}
+}
+
+type statusRecorder struct {
+	statuses chan Info
+}
+
+func (s *statusRecorder) EditStatus(_ context.Context, _ int, down *Info) error {
+	s.statuses <- *down
+	return nil
+}
+
+// runDownload downloads the game and returns the statuses it went through
+func runDownload(t *testing.T, srv *httptest.Server, folder string) []Status {
+	statuses, last := runDownloadUntilDone(t, srv, folder)
+	require.Equal(t, StatusComplete, last.Status, last.StatusMessage)
+	return statuses
+}
+
+// runDownloadUntilDone waits for the download to complete or fail
+func runDownloadUntilDone(t *testing.T, srv *httptest.Server, folder string) ([]Status, Info) {
+	rec := &statusRecorder{statuses: make(chan Info, 16)}
+	_, err := NewDownload(testConfig{}, func(int, Status) {}, rec, srv.URL, folder, 1)
+	require.NoError(t, err)
+
+	var statuses []Status
+	for {
+		select {
+		case info := <-rec.statuses:
+			statuses = append(statuses, info.Status)
+			if info.Status == StatusComplete || info.Status == StatusError {
+				waitCacheClosed(t, folder)
+				return statuses, info
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("download did not finish")
+		}
+	}
+}
+
+// the cache store is closed after the last status, badger locks the dir until then
+func waitCacheClosed(t *testing.T, folder string) {
+	require.Eventually(t, func() bool {
+		db, err := NewCacheStoreBadger(filepath.Join(folder, MetadataFolder))
+		if err != nil {
+			return false
+		}
+		return db.Close() == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDeltaUpdate(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"game.exe":        "version one exe",
+		"data/level1.pak": "level one",
+		"data/old/gone":   "removed in the patch",
+	})
+	folder := t.TempDir()
+
+	statuses := runDownload(t, srv, folder)
+	require.Equal(t, []Status{StatusMetadata, StatusDownloading, StatusComplete}, statuses)
+	require.Len(t, fs.fetched, 3)
+
+	fs.set(map[string]string{
+		"game.exe":        "version two exe, now longer",
+		"data/level1.pak": "level one",
+		"data/level2.pak": "level two",
+	})
+
+	statuses = runDownload(t, srv, folder)
+	require.Equal(t, []Status{StatusMetadata, StatusUpdating, StatusComplete}, statuses)
+	// the unchanged file is not downloaded again
+	require.Equal(t, map[string]bool{"game.exe": true, "data/level2.pak": true}, fs.fetched)
+
+	contents, err := os.ReadFile(filepath.Join(folder, "game.exe"))
+	require.NoError(t, err)
+	require.Equal(t, "version two exe, now longer", string(contents))
+	require.FileExists(t, filepath.Join(folder, "data", "level2.pak"))
+
+	require.NoFileExists(t, filepath.Join(folder, "data", "old", "gone"))
+	require.NoDirExists(t, filepath.Join(folder, "data", "old"))
+}
+
+func TestBlockPatch(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbbccccdddd",
+	})
+	folder := t.TempDir()
+	runDownload(t, srv, folder)
+	require.Len(t, fs.ranges["data.pak"], 4)
+
+	// second block changed and the file grew by a block
+	fs.set(map[string]string{
+		"data.pak": "aaaaXXXXccccddddeeee",
+	})
+	runDownload(t, srv, folder)
+	require.ElementsMatch(t, []string{"bytes=4-7", "bytes=16-19"}, fs.ranges["data.pak"])
+
+	contents, err := os.ReadFile(filepath.Join(folder, "data.pak"))
+	require.NoError(t, err)
+	require.Equal(t, "aaaaXXXXccccddddeeee", string(contents))
+}
+
+func TestNoBlocksDownloadsWholeFile(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbbcccc",
+	})
+	fs.noBlocks = true
+	fs.legacy = true
+	folder := t.TempDir()
+	runDownload(t, srv, folder)
+
+	fs.set(map[string]string{
+		"data.pak": "aaaaXXXXcccc",
+	})
+	runDownload(t, srv, folder)
+	require.Len(t, fs.ranges["data.pak"], 3)
+}
@@ -2,21 +2,24 @@ package download
 
 import (
 	"context"
-	"encoding/gob"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/ra341/glacier/internal/library"
 	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/ra341/glacier/pkg/pubsub"
 
 	"github.com/dustin/go-humanize"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 )
 
 type Config interface {
@@ -24,13 +27,21 @@ type Config interface {
 	getChunkSize() int64
 	getMaxConcurrentFileChunks() int
 	getHttpClient() *http.Client
+	getRetryPolicy() RetryPolicy
+	getThrottle() *Throttle
+	// nil when nobody can watch the progress
+	getEvents() *pubsub.Broker[ProgressEvent]
+	// nil to skip the free space check
+	getSpace() *fileutil.SpaceReserver
 }
 
 type ProgressUpdater interface {
 	EditStatus(ctx context.Context, id int, down *Info) error
 }
 
-type OnDone func(id int)
+// OnDone is called once the download exits with the last status it saved,
+// StatusComplete or StatusError when it finished
+type OnDone func(id int, status Status)
 
 type Download struct {
 	ctx    context.Context
@@ -46,10 +57,23 @@ type Download struct {
 	OnDone         OnDone
 	cacheStore     CacheStore
 	progress       ProgressUpdater
+	retries        *retryBudget
+	// GameLimit of the throttle, nil when not throttled
+	limiter *rate.Limiter
+	tracker *progressTracker
+	// last saved status, only used by the goroutine of Start
+	status Status
+
+	// closed once Start returns and the cache store is closed
+	done chan struct{}
 }
 
 const MetadataFolder = ".frost.cache"
 
+func metadataUrl(baseUrl string, gameId int) string {
+	return fmt.Sprintf("%s/meta/%d", baseUrl, gameId)
+}
+
 func NewDownload(
 	config Config,
 	OnDone OnDone,
@@ -72,7 +96,7 @@ func NewDownload(
 		OnDone: OnDone,
 
 		downloadUrlBase: fmt.Sprintf("%s/load/%d", baseUrl, gameId),
-		metadataUrlBase: fmt.Sprintf("%s/meta/%d", baseUrl, gameId),
+		metadataUrlBase: metadataUrl(baseUrl, gameId),
 		gameId:          gameId,
 		downloadFolder:  downloadFolder,
 
@@ -80,6 +104,10 @@ func NewDownload(
 		progress: progress,
 
 		cacheStore: db,
+		retries:    &retryBudget{policy: config.getRetryPolicy()},
+		limiter:    config.getThrottle().newGameLimiter(),
+		tracker:    newProgressTracker(),
+		done:       make(chan struct{}),
 	}
 	go d.Start()
 
@@ -87,39 +115,100 @@ func NewDownload(
 }
 
 func (d *Download) Start() {
+	defer close(d.done)
+	// frees the download slot however the download ends,
+	// after the cache is closed so the folder can be opened again
+	defer func() { d.OnDone(d.gameId, d.status) }()
 	defer fileutil.Close(d.cacheStore)
 
-	warnIfErr(d.progress.EditStatus(d.ctx, d.gameId, &Info{
+	d.setStatus(&Info{
 		Status:        StatusMetadata,
 		StatusMessage: "Downloading Metadata",
-	}))
+	})
 
 	var meta library.FolderManifest
 	err := d.downloadMetadata(&meta)
 	if err != nil {
-		warnIfErr(d.progress.EditStatus(d.ctx, d.gameId, &Info{
+		d.setStatus(&Info{
 			Status:        StatusError,
 			StatusMessage: "could not download metadata",
-		}))
+		})
+		return
+	}
+
+	dt, err := d.diff(&meta)
+	if err != nil {
+		log.Error().Err(err).Msg("could not compare local files")
+		d.setStatus(&Info{
+			Status:        StatusError,
+			StatusMessage: "could not compare local files: " + err.Error(),
+		})
 		return
 	}
 
-	warnIfErr(d.progress.EditStatus(d.ctx, d.gameId, &Info{
+	var total, remaining int64
+	for _, fm := range meta.FileInfo {
+		total += fm.Size
+	}
+	for _, task := range dt.download {
+		remaining += task.fm.Size
+	}
+	d.tracker.setTotals(total, total-remaining)
+
+	err = d.reserveSpace(dt)
+	defer d.releaseSpace()
+	if err != nil {
+		log.Error().Err(err).Int("game", d.gameId).Msg("could not reserve disk space")
+		d.setStatus(&Info{
+			Status:        StatusError,
+			StatusMessage: err.Error(),
+		})
+		return
+	}
+
+	status := &Info{
 		Status:        StatusDownloading,
 		StatusMessage: "starting file download",
-	}))
+	}
+	if dt.update {
+		status = &Info{
+			Status: StatusUpdating,
+			StatusMessage: fmt.Sprintf(
+				"updating %d files, removing %d, %d unchanged",
+				len(dt.download), len(dt.removed), dt.unchanged,
+			),
+		}
+	}
+	d.setStatus(status)
+
+	err = d.removeFiles(dt.removed)
+	if err != nil {
+		log.Error().Err(err).Msg("could not remove deleted files")
+		d.setStatus(&Info{
+			Status:        StatusError,
+			StatusMessage: "could not remove deleted files: " + err.Error(),
+		})
+		return
+	}
 
 	eg := errgroup.Group{}
-	eg.SetLimit(d.conf.getMaxConcurrentFileChunks())
+	eg.SetLimit(d.conf.getMaxConcurrentFiles())
 
-	for _, fi := range meta.FileInfo {
+	for _, task := range dt.download {
+		fi := task.fm
 		eg.Go(func() error {
-			err := d.setupFile(&fi)
-			if err != nil {
-				return fmt.Errorf("could not setup file metadata: %w", err)
+			if err := d.ctx.Err(); err != nil {
+				return err
+			}
+
+			if task.fresh {
+				err := d.setupFile(&fi, task.patch)
+				if err != nil {
+					return fmt.Errorf("could not setup file metadata: %w", err)
+				}
 			}
 
-			err = d.downloadFile(&fi)
+			err := d.downloadVerified(&fi)
 			if err != nil {
 				return fmt.Errorf("could not download file: %w", err)
 			}
@@ -129,12 +218,16 @@ func (d *Download) Start() {
 	}
 
 	err = eg.Wait()
+	if d.ctx.Err() != nil {
+		log.Info().Int("game", d.gameId).Msg("download stopped")
+		return
+	}
 	if err != nil {
 		log.Error().Err(err).Msg("error downloading")
-		warnIfErr(d.progress.EditStatus(d.ctx, d.gameId, &Info{
+		d.setStatus(&Info{
 			Status:        StatusError,
 			StatusMessage: "error downloading: " + err.Error(),
-		}))
+		})
 		return
 	}
 
@@ -142,14 +235,74 @@ func (d *Download) Start() {
 		Int("game", d.gameId).
 		Msg("download finished")
 
-	// remove from download tracker
-	d.OnDone(d.gameId)
-
-	warnIfErr(d.progress.EditStatus(d.ctx, d.gameId, &Info{
+	d.setStatus(&Info{
 		Status:        StatusComplete,
 		StatusMessage: "Download Complete",
 		Done:          time.Now(),
-	}))
+	})
+}
+
+// Stop cancels the download and waits for it to exit,
+// finished chunks stay in the cache so a new Download of the folder resumes from them
+func (d *Download) Stop() {
+	d.cancel()
+	<-d.done
+}
+
+// Running is false once the download finished, failed or was stopped
+func (d *Download) Running() bool {
+	select {
+	case <-d.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// setStatus saves the status, a stopped download leaves it to whoever stopped it
+func (d *Download) setStatus(info *Info) {
+	if d.ctx.Err() != nil {
+		return
+	}
+	d.status = info.Status
+	warnIfErr(d.progress.EditStatus(context.Background(), d.gameId, info))
+	d.publish(d.tracker.setStatus(d.gameId, info))
+}
+
+// reserveSpace fails early when the disk cannot hold the files left to download,
+// the space of files that already exist is counted as used
+func (d *Download) reserveSpace(dt *delta) error {
+	space := d.conf.getSpace()
+	if space == nil {
+		return nil
+	}
+
+	var needed int64
+	for _, task := range dt.download {
+		size := task.fm.Size
+		if stat, err := os.Stat(filepath.Join(d.downloadFolder, task.fm.RelPath)); err == nil {
+			size -= stat.Size()
+		}
+		needed += max(size, 0)
+	}
+
+	return space.Reserve(d.spaceKey(), d.downloadFolder, uint64(needed))
+}
+
+func (d *Download) releaseSpace() {
+	if space := d.conf.getSpace(); space != nil {
+		space.Release(d.spaceKey())
+	}
+}
+
+func (d *Download) spaceKey() string {
+	return strconv.Itoa(d.gameId)
+}
+
+func (d *Download) publish(event ProgressEvent) {
+	if events := d.conf.getEvents(); events != nil {
+		events.Publish(event)
+	}
 }
 
 func (d *Download) Progress() (complete []FileProgress, total error) {
@@ -170,45 +323,51 @@ func (d *Download) Close() {
 // metadata step
 
 func (d *Download) downloadMetadata(meta *library.FolderManifest) error {
-	resp, err := d.conf.getHttpClient().Get(d.metadataUrlBase)
+	var err error
+	*meta, err = fetchManifest(d.ctx, d.conf.getHttpClient(), d.metadataUrlBase)
+	return err
+}
+
+func fetchManifest(ctx context.Context, client *http.Client, url string) (library.FolderManifest, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return err
+		return library.FolderManifest{}, err
+	}
+	req.Header.Set("Accept", library.ContentTypeProtobuf)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return library.FolderManifest{}, err
 	}
 	defer fileutil.Close(resp.Body)
 
 	err = checkHttpErr(resp)
 	if err != nil {
-		return err
+		return library.FolderManifest{}, err
 	}
 
-	decoder := gob.NewDecoder(resp.Body)
-	return decoder.Decode(meta)
+	return library.DecodeManifest(resp.Body, resp.Header.Get("Content-Type"))
 }
 
-func (d *Download) setupFile(fm *library.FileManifest) error {
+// setupFile allocates the file and queues its chunks,
+// with patch only the chunks that differ from the local file are queued
+func (d *Download) setupFile(fm *library.FileManifest, patch bool) error {
 	started := time.Now()
 
 	fullPath := filepath.Join(d.downloadFolder, fm.RelPath)
+	chunkList := d.splitChunks(fm)
 
-	_, found, err := d.cacheStore.Get(fullPath)
-	if err != nil {
-		return err
-	}
-	if found {
-		stat, err := os.Stat(fullPath)
+	reused := 0
+	if patch {
+		// must run before the file is resized
+		var err error
+		reused, err = d.reuseBlocks(fullPath, fm, chunkList)
 		if err != nil {
-			return err
-		}
-
-		isModified := fm.ModTime.After(stat.ModTime())
-		if !isModified {
-			// file is unmodified from the server
-			// continue downloading
-			return nil
+			return fmt.Errorf("could not compare blocks: %w", err)
 		}
 	}
 
-	err = os.MkdirAll(filepath.Dir(fullPath), 0755)
+	err := os.MkdirAll(filepath.Dir(fullPath), 0755)
 	if err != nil {
 		return err
 	}
@@ -226,10 +385,33 @@ func (d *Download) setupFile(fm *library.FileManifest) error {
 		return err
 	}
 
+	err = d.cacheStore.Add(fullPath, fm.Checksum, chunkList)
+	if err != nil {
+		return err
+	}
+
+	elapsed := time.Now().Sub(started)
+	log.Info().Str("elapsed", elapsed.String()).
+		Int("chunks", len(chunkList)).
+		Int("reused", reused).
+		Str("file", filepath.Base(fullPath)).
+		Msg("completed download setup")
+
+	return nil
+}
+
+// splitChunks splits the file along the manifest blocks,
+// manifests without blocks use the configured chunk size
+func (d *Download) splitChunks(fm *library.FileManifest) []Chunk {
+	chunkSize := fm.BlockSize
+	if chunkSize <= 0 {
+		chunkSize = d.conf.getChunkSize()
+	}
+
 	var chunkList []Chunk
 	totalSize := fm.Size
-	for start := int64(0); start < totalSize; start += d.conf.getChunkSize() {
-		end := start + d.conf.getChunkSize() - 1
+	for start := int64(0); start < totalSize; start += chunkSize {
+		end := start + chunkSize - 1
 		// if it's the last chunk,
 		// make sure not to overshoot the file size
 		if end >= totalSize {
@@ -245,18 +427,25 @@ func (d *Download) setupFile(fm *library.FileManifest) error {
 		chunkList = append(chunkList, chunk)
 	}
 
-	err = d.cacheStore.Add(fullPath, chunkList)
+	return chunkList
+}
+
+// reuseBlocks marks the chunks whose block hash matches the local file as complete
+func (d *Download) reuseBlocks(fullPath string, fm *library.FileManifest, chunks []Chunk) (int, error) {
+	_, local, err := library.GetBlockHashes(fullPath, fm.BlockSize)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	elapsed := time.Now().Sub(started)
-	log.Info().Str("elapsed", elapsed.String()).
-		Any("meta", chunkList).
-		Str("file", filepath.Base(fullPath)).
-		Msg("completed download setup")
+	reused := 0
+	for i := range chunks {
+		if i < len(local) && i < len(fm.Blocks) && local[i] == fm.Blocks[i] {
+			chunks[i].State = ChunkComplete
+			reused++
+		}
+	}
 
-	return nil
+	return reused, nil
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -280,6 +469,14 @@ func (d *Download) downloadFile(fm *library.FileManifest) error {
 		return fmt.Errorf("file %s not found in cache, THIS SHOULD NEVER HAPPEN", fm.RelPath)
 	}
 
+	var cached int64
+	for _, chunk := range chunks {
+		if chunk.State == ChunkComplete {
+			cached += chunk.End - chunk.Start + 1
+		}
+	}
+	d.tracker.resetFile(fm.RelPath, fm.Size, cached)
+
 	file, err := os.OpenFile(fullPath, os.O_RDWR, 0755)
 	if err != nil {
 		return err
@@ -301,7 +498,7 @@ func (d *Download) downloadFile(fm *library.FileManifest) error {
 				return nil
 			}
 
-			errInner := d.downloadWithRange(fileUrl, &chunk, file, fm.ModTime)
+			errInner := d.downloadChunk(fileUrl, &chunk, file, fm.ModTime)
 			if errInner != nil {
 				log.Error().Err(errInner).
 					Int64("start", chunk.Start).Int64("end", chunk.End).
@@ -315,7 +512,14 @@ func (d *Download) downloadFile(fm *library.FileManifest) error {
 			if err != nil {
 				log.Warn().Err(err).Msg("could not update chunk to cache")
 			}
-			return nil
+			if errInner == nil {
+				size := chunk.End - chunk.Start + 1
+				d.publish(d.tracker.chunkDone(d.gameId, fm.RelPath, size))
+				if space := d.conf.getSpace(); space != nil {
+					space.Consume(d.spaceKey(), uint64(size))
+				}
+			}
+			return errInner
 		})
 	}
 
@@ -336,7 +540,8 @@ func (d *Download) downloadFile(fm *library.FileManifest) error {
 
 	if fm.Checksum != hash {
 		return fmt.Errorf(
-			"checksum mismatch, expected: %s != got: %s\nExpected Size: %s, got size: %s",
+			"%w, expected: %s != got: %s\nExpected Size: %s, got size: %s",
+			ErrChecksumMismatch,
 			fm.Checksum,
 			hash,
 			humanize.Bytes(uint64(fm.Size)),
@@ -353,8 +558,48 @@ func (d *Download) downloadFile(fm *library.FileManifest) error {
 	return nil
 }
 
+// downloadVerified downloads the file, re-queuing only its chunks when the checksum does not match
+func (d *Download) downloadVerified(fm *library.FileManifest) error {
+	for {
+		err := d.downloadFile(fm)
+		if !errors.Is(err, ErrChecksumMismatch) || !d.retries.take() {
+			return err
+		}
+
+		log.Warn().Err(err).Str("file", fm.RelPath).Msg("re-queuing file after checksum mismatch")
+
+		// blocks that match are kept when the manifest has block hashes
+		err = d.setupFile(fm, len(fm.Blocks) > 0)
+		if err != nil {
+			return fmt.Errorf("could not re-queue file: %w", err)
+		}
+	}
+}
+
+// downloadChunk downloads the range, retrying transient failures with backoff
+func (d *Download) downloadChunk(url string, chunk *Chunk, writer io.WriterAt, modTime time.Time) error {
+	for attempt := 0; ; attempt++ {
+		err := d.downloadWithRange(url, chunk, writer, modTime)
+		if err == nil {
+			return nil
+		}
+		if !retryable(err) || !d.retries.take() {
+			return err
+		}
+
+		log.Warn().Err(err).
+			Int("attempt", attempt+1).
+			Int64("start", chunk.Start).Int64("end", chunk.End).
+			Msg("retrying chunk")
+
+		if !d.retries.wait(d.ctx, attempt) {
+			return d.ctx.Err()
+		}
+	}
+}
+
 func (d *Download) downloadWithRange(url string, chunk *Chunk, writer io.WriterAt, modTime time.Time) error {
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(d.ctx, "GET", url, nil)
 	if err != nil {
 		return err
 	}
@@ -377,7 +622,8 @@ func (d *Download) downloadWithRange(url string, chunk *Chunk, writer io.WriterA
 		return fmt.Errorf("server did not support range or file changed: status %s", resp.Status)
 	}
 
-	_, err = io.Copy(NewOffsetWriter(writer, chunk.Start), resp.Body)
+	body := d.conf.getThrottle().reader(d.ctx, resp.Body, d.limiter)
+	_, err = io.Copy(NewOffsetWriter(writer, chunk.Start), body)
 	return err
 }
 
@@ -390,7 +636,10 @@ func checkHttpErr(resp *http.Response) error {
 	if err != nil {
 		return fmt.Errorf("could not load body to get error message: %w", err)
 	}
-	return fmt.Errorf("error downloading: %d: %s", resp.StatusCode, string(all))
+	return &httpStatusError{
+		code: resp.StatusCode,
+		msg:  fmt.Sprintf("error downloading: %d: %s", resp.StatusCode, string(all)),
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -8,11 +8,11 @@ import (
 	"strings"
 )
 
-const _StatusName = "StatusQueuedStatusMetadataStatusDownloadingStatusErrorStatusComplete"
+const _StatusName = "StatusQueuedStatusMetadataStatusDownloadingStatusUpdatingStatusErrorStatusCompleteStatusPaused"
 
-var _StatusIndex = [...]uint8{0, 12, 26, 43, 54, 68}
+var _StatusIndex = [...]uint8{0, 12, 26, 43, 57, 68, 82, 94}
 
-const _StatusLowerName = "statusqueuedstatusmetadatastatusdownloadingstatuserrorstatuscomplete"
+const _StatusLowerName = "statusqueuedstatusmetadatastatusdownloadingstatusupdatingstatuserrorstatuscompletestatuspaused"
 
 func (i Status) String() string {
 	if i < 0 || i >= Status(len(_StatusIndex)-1) {
@@ -28,11 +28,13 @@ func _StatusNoOp() {
 	_ = x[StatusQueued-(0)]
 	_ = x[StatusMetadata-(1)]
 	_ = x[StatusDownloading-(2)]
-	_ = x[StatusError-(3)]
-	_ = x[StatusComplete-(4)]
+	_ = x[StatusUpdating-(3)]
+	_ = x[StatusError-(4)]
+	_ = x[StatusComplete-(5)]
+	_ = x[StatusPaused-(6)]
 }
 
-var _StatusValues = []Status{StatusQueued, StatusMetadata, StatusDownloading, StatusError, StatusComplete}
+var _StatusValues = []Status{StatusQueued, StatusMetadata, StatusDownloading, StatusUpdating, StatusError, StatusComplete, StatusPaused}
 
 var _StatusNameToValueMap = map[string]Status{
 	_StatusName[0:12]:       StatusQueued,
@@ -41,18 +43,24 @@ var _StatusNameToValueMap = map[string]Status{
 	_StatusLowerName[12:26]: StatusMetadata,
 	_StatusName[26:43]:      StatusDownloading,
 	_StatusLowerName[26:43]: StatusDownloading,
-	_StatusName[43:54]:      StatusError,
-	_StatusLowerName[43:54]: StatusError,
-	_StatusName[54:68]:      StatusComplete,
-	_StatusLowerName[54:68]: StatusComplete,
+	_StatusName[43:57]:      StatusUpdating,
+	_StatusLowerName[43:57]: StatusUpdating,
+	_StatusName[57:68]:      StatusError,
+	_StatusLowerName[57:68]: StatusError,
+	_StatusName[68:82]:      StatusComplete,
+	_StatusLowerName[68:82]: StatusComplete,
+	_StatusName[82:94]:      StatusPaused,
+	_StatusLowerName[82:94]: StatusPaused,
 }
 
 var _StatusNames = []string{
 	_StatusName[0:12],
 	_StatusName[12:26],
 	_StatusName[26:43],
-	_StatusName[43:54],
-	_StatusName[54:68],
+	_StatusName[43:57],
+	_StatusName[57:68],
+	_StatusName[68:82],
+	_StatusName[82:94],
 }
 
 // StatusString retrieves an enum value from the enum constants string name.
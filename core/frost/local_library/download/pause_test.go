@@ -0,0 +1,69 @@
+package download
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopAndResume(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbbcccc",
+	})
+
+	// the last block never finishes until the download is stopped
+	stuck := make(chan struct{})
+	fs.hold = func(r *http.Request) {
+		if r.Header.Get("Range") != "bytes=8-11" {
+			return
+		}
+		select {
+		case stuck <- struct{}{}:
+		default:
+		}
+		<-r.Context().Done()
+	}
+
+	folder := t.TempDir()
+	rec := &statusRecorder{statuses: make(chan Info, 16)}
+	dn, err := NewDownload(testConfig{}, func(int, Status) {}, rec, srv.URL, folder, 1)
+	require.NoError(t, err)
+
+	select {
+	case <-stuck:
+	case <-time.After(5 * time.Second):
+		t.Fatal("download never reached the last block")
+	}
+	// let the other blocks finish
+	require.Eventually(t, func() bool {
+		progress, err := dn.Progress()
+		return err == nil && len(progress) == 1 && progress[0].Complete == 8
+	}, 5*time.Second, 10*time.Millisecond)
+
+	dn.Stop()
+	require.False(t, dn.Running())
+
+	// no complete or error status after a stop
+	for len(rec.statuses) > 0 {
+		info := <-rec.statuses
+		require.NotEqual(t, StatusComplete, info.Status)
+		require.NotEqual(t, StatusError, info.Status)
+	}
+
+	fs.mu.Lock()
+	fs.hold = nil
+	fs.ranges = map[string][]string{}
+	fs.mu.Unlock()
+
+	runDownload(t, srv, folder)
+	// only the unfinished block is downloaded again
+	require.Equal(t, []string{"bytes=8-11"}, fs.ranges["data.pak"])
+
+	contents, err := os.ReadFile(filepath.Join(folder, "data.pak"))
+	require.NoError(t, err)
+	require.Equal(t, "aaaabbbbcccc", string(contents))
+}
@@ -0,0 +1,159 @@
+package download
+
+import (
+	"sync"
+	"time"
+)
+
+// speedWindow is how far back the throughput is averaged
+const speedWindow = 10 * time.Second
+
+// ProgressEvent is sent when a chunk finishes or the status of a download changes
+type ProgressEvent struct {
+	GameId        int
+	Status        Status
+	StatusMessage string
+
+	// rel path of the file the chunk belongs to, empty for status changes
+	File         string
+	FileComplete int64
+	FileSize     int64
+
+	// bytes of the whole game, including files that did not need downloading
+	Complete int64
+	Total    int64
+
+	// average over the last few seconds
+	BytesPerSec int64
+	// 0 until the speed is known
+	ETA time.Duration
+}
+
+type speedSample struct {
+	at         time.Time
+	downloaded int64
+}
+
+// progressTracker keeps the byte counts of a download between chunks
+type progressTracker struct {
+	mu sync.Mutex
+
+	status        Status
+	statusMessage string
+
+	total    int64
+	complete int64
+	// rel path -> complete bytes
+	files map[string]int64
+	sizes map[string]int64
+
+	// bytes transferred in this session, reused or resumed chunks are not counted
+	downloaded int64
+	samples    []speedSample
+	now        func() time.Time
+}
+
+func newProgressTracker() *progressTracker {
+	return &progressTracker{
+		files: map[string]int64{},
+		sizes: map[string]int64{},
+		now:   time.Now,
+	}
+}
+
+// setTotals is called once the files to download are known,
+// unchanged is the size of the files that are already complete
+func (p *progressTracker) setTotals(total, unchanged int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.total = total
+	p.complete = unchanged
+	for _, done := range p.files {
+		p.complete += done
+	}
+	// the first chunk is measured from here
+	p.samples = append(p.samples[:0], speedSample{at: p.now(), downloaded: p.downloaded})
+}
+
+// resetFile sets the bytes of the file found complete in the cache,
+// the file may have been counted before a checksum mismatch re-queued it
+func (p *progressTracker) resetFile(rel string, size, complete int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.complete += complete - p.files[rel]
+	p.files[rel] = complete
+	p.sizes[rel] = size
+}
+
+// chunkDone counts a downloaded chunk and returns the event for it
+func (p *progressTracker) chunkDone(gameId int, rel string, size int64) ProgressEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.files[rel] += size
+	p.complete += size
+	p.downloaded += size
+	p.sample()
+
+	event := p.event(gameId)
+	event.File = rel
+	event.FileComplete = p.files[rel]
+	event.FileSize = p.sizes[rel]
+	return event
+}
+
+// setStatus returns the event for the new status
+func (p *progressTracker) setStatus(gameId int, info *Info) ProgressEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.status = info.Status
+	p.statusMessage = info.StatusMessage
+	return p.event(gameId)
+}
+
+// event must be called with mu held
+func (p *progressTracker) event(gameId int) ProgressEvent {
+	event := ProgressEvent{
+		GameId:        gameId,
+		Status:        p.status,
+		StatusMessage: p.statusMessage,
+		Complete:      p.complete,
+		Total:         p.total,
+		BytesPerSec:   p.speed(),
+	}
+	if event.BytesPerSec > 0 && p.total > p.complete {
+		event.ETA = time.Duration(float64(p.total-p.complete) / float64(event.BytesPerSec) * float64(time.Second))
+	}
+	return event
+}
+
+// sample must be called with mu held
+func (p *progressTracker) sample() {
+	now := p.now()
+	p.samples = append(p.samples, speedSample{at: now, downloaded: p.downloaded})
+
+	// keep one sample older than the window so the speed covers all of it
+	cutoff := now.Add(-speedWindow)
+	drop := 0
+	for drop < len(p.samples)-2 && !p.samples[drop+1].at.After(cutoff) {
+		drop++
+	}
+	p.samples = p.samples[drop:]
+}
+
+// speed must be called with mu held
+func (p *progressTracker) speed() int64 {
+	if len(p.samples) < 2 {
+		return 0
+	}
+
+	first, last := p.samples[0], p.samples[len(p.samples)-1]
+	elapsed := last.at.Sub(first.at).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return int64(float64(last.downloaded-first.downloaded) / elapsed)
+}
@@ -0,0 +1,81 @@
+package download
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressEvents(t *testing.T) {
+	srv, _ := newFakeServer(t, map[string]string{
+		"game.exe": "aaaabbbbcc",
+		"data.pak": "dddd",
+	})
+
+	rec := &gameStatuses{statuses: map[int][]Status{}}
+	srvc := &Service{
+		progress:                rec,
+		baseurl:                 srv.URL,
+		httpClient:              testConfig{}.getHttpClient(),
+		maxConcurrentFiles:      testConfig{}.getMaxConcurrentFiles(),
+		maxConcurrentFileChunks: testConfig{}.getMaxConcurrentFileChunks(),
+		chunkSize:               testConfig{}.getChunkSize(),
+		retryPolicy:             testConfig{}.getRetryPolicy(),
+	}
+
+	events, unsubscribe := srvc.Watch()
+	defer unsubscribe()
+
+	require.NoError(t, srvc.Download(1, t.TempDir(), 0))
+
+	chunks := map[string][]int64{}
+	var last ProgressEvent
+	for last.Status != StatusComplete {
+		select {
+		case last = <-events:
+			require.Equal(t, 1, last.GameId)
+			if last.File != "" {
+				chunks[last.File] = append(chunks[last.File], last.FileComplete)
+				require.Equal(t, int64(14), last.Total)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("download did not finish")
+		}
+	}
+
+	// one event per chunk, chunks finish in any order
+	require.Len(t, chunks["game.exe"], 3)
+	require.Equal(t, int64(10), slices.Max(chunks["game.exe"]))
+	require.Equal(t, []int64{4}, chunks["data.pak"])
+	require.Equal(t, int64(14), last.Complete)
+	require.Zero(t, last.ETA)
+}
+
+func TestProgressSpeed(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := newProgressTracker()
+	tracker.now = func() time.Time { return now }
+
+	tracker.setTotals(100*MB, 20*MB)
+	tracker.resetFile("game.exe", 80*MB, 0)
+
+	now = now.Add(2 * time.Second)
+	event := tracker.chunkDone(1, "game.exe", 20*MB)
+	require.Equal(t, int64(40*MB), event.Complete)
+	require.Equal(t, int64(10*MB), event.BytesPerSec)
+	require.Equal(t, 6*time.Second, event.ETA)
+
+	// a stall drops the older samples, one sample before the window is kept
+	now = now.Add(20 * time.Second)
+	tracker.chunkDone(1, "game.exe", 20*MB)
+	now = now.Add(time.Second)
+	event = tracker.chunkDone(1, "game.exe", 20*MB)
+	require.Equal(t, int64(40*MB)/21, event.BytesPerSec)
+
+	// a re-queued file is counted from its cached chunks again
+	tracker.resetFile("game.exe", 80*MB, 0)
+	event = tracker.setStatus(1, &Info{Status: StatusDownloading})
+	require.Equal(t, int64(20*MB), event.Complete)
+}
@@ -0,0 +1,145 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+)
+
+// queued games wait for a free download slot, highest priority first
+
+type queueItem struct {
+	gameId   int
+	gamePath string
+	priority int
+	// keeps insertion order for equal priorities
+	seq uint64
+}
+
+// QueueEntry is a game waiting to be downloaded
+type QueueEntry struct {
+	GameId   int
+	Priority int
+	// 0 is the next game to start
+	Position int
+}
+
+// enqueue adds the game to the queue or updates its priority if already queued
+func (d *Service) enqueue(gameId int, gamePath string, priority int) error {
+	if _, active := d.ActiveDownloads.Load(gameId); active {
+		return fmt.Errorf("game %d is already downloading", gameId)
+	}
+
+	// set before it is queued, a free slot can start it right away
+	warnIfErr(d.progress.EditStatus(context.Background(), gameId, &Info{
+		Status:        StatusQueued,
+		StatusMessage: "Waiting for a download slot",
+	}))
+
+	d.queueMu.Lock()
+	idx := d.queueIndex(gameId)
+	if idx >= 0 {
+		d.queue[idx].priority = priority
+		d.queue[idx].gamePath = gamePath
+	} else {
+		d.queueSeq++
+		d.queue = append(d.queue, queueItem{
+			gameId:   gameId,
+			gamePath: gamePath,
+			priority: priority,
+			seq:      d.queueSeq,
+		})
+	}
+	d.sortQueue()
+	d.queueMu.Unlock()
+
+	d.schedule()
+	return nil
+}
+
+// dequeue removes the game from the queue, false if it was not queued
+func (d *Service) dequeue(gameId int) bool {
+	d.queueMu.Lock()
+	defer d.queueMu.Unlock()
+
+	idx := d.queueIndex(gameId)
+	if idx < 0 {
+		return false
+	}
+	d.queue = slices.Delete(d.queue, idx, idx+1)
+	return true
+}
+
+// SetPriority reorders a queued game, false if the game is not queued
+func (d *Service) SetPriority(gameId int, priority int) bool {
+	d.queueMu.Lock()
+	defer d.queueMu.Unlock()
+
+	idx := d.queueIndex(gameId)
+	if idx < 0 {
+		return false
+	}
+	d.queue[idx].priority = priority
+	d.sortQueue()
+	return true
+}
+
+// Queue lists the queued games in the order they will start
+func (d *Service) Queue() []QueueEntry {
+	d.queueMu.Lock()
+	defer d.queueMu.Unlock()
+
+	entries := make([]QueueEntry, 0, len(d.queue))
+	for i, item := range d.queue {
+		entries = append(entries, QueueEntry{
+			GameId:   item.gameId,
+			Priority: item.priority,
+			Position: i,
+		})
+	}
+	return entries
+}
+
+// schedule starts queued games while there are free download slots
+func (d *Service) schedule() {
+	d.queueMu.Lock()
+	defer d.queueMu.Unlock()
+
+	for len(d.queue) > 0 {
+		if d.maxConcurrentGames > 0 && len(d.ActiveDownloads.Keys()) >= d.maxConcurrentGames {
+			return
+		}
+
+		next := d.queue[0]
+		d.queue = d.queue[1:]
+
+		err := d.start(next.gameId, next.gamePath)
+		if err != nil {
+			log.Warn().Err(err).Int("game", next.gameId).Msg("could not start queued download")
+			warnIfErr(d.progress.EditStatus(context.Background(), next.gameId, &Info{
+				Status:        StatusError,
+				StatusMessage: err.Error(),
+			}))
+			d.finish(next.gameId, StatusError)
+		}
+	}
+}
+
+// sortQueue must be called with queueMu held
+func (d *Service) sortQueue() {
+	slices.SortStableFunc(d.queue, func(a, b queueItem) int {
+		if a.priority != b.priority {
+			return b.priority - a.priority
+		}
+		return int(a.seq) - int(b.seq)
+	})
+}
+
+// queueIndex must be called with queueMu held
+func (d *Service) queueIndex(gameId int) int {
+	return slices.IndexFunc(d.queue, func(item queueItem) bool {
+		return item.gameId == gameId
+	})
+}
@@ -0,0 +1,142 @@
+package download
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// gameStatuses records the statuses of every game
+type gameStatuses struct {
+	mu       sync.Mutex
+	statuses map[int][]Status
+	// game ids in the order they started downloading
+	started []int
+}
+
+func (g *gameStatuses) EditStatus(_ context.Context, gameId int, down *Info) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.statuses[gameId] = append(g.statuses[gameId], down.Status)
+	if down.Status == StatusMetadata {
+		g.started = append(g.started, gameId)
+	}
+	return nil
+}
+
+func (g *gameStatuses) last(gameId int) Status {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	list := g.statuses[gameId]
+	if len(list) == 0 {
+		return -1
+	}
+	return list[len(list)-1]
+}
+
+func TestQueuePriority(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbb",
+	})
+
+	// game 1 holds the only slot until released
+	release := make(chan struct{})
+	fs.hold = func(r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/load/1/") {
+			<-release
+		}
+	}
+
+	rec := &gameStatuses{statuses: map[int][]Status{}}
+	srvc := &Service{
+		progress:                rec,
+		baseurl:                 srv.URL,
+		httpClient:              http.DefaultClient,
+		maxConcurrentFiles:      testConfig{}.getMaxConcurrentFiles(),
+		maxConcurrentFileChunks: testConfig{}.getMaxConcurrentFileChunks(),
+		chunkSize:               testConfig{}.getChunkSize(),
+		retryPolicy:             testConfig{}.getRetryPolicy(),
+		maxConcurrentGames:      1,
+	}
+
+	folder := t.TempDir()
+	require.NoError(t, srvc.Download(1, folder, 0))
+	require.NoError(t, srvc.Download(2, folder, 0))
+	require.NoError(t, srvc.Download(3, folder, 0))
+	require.Error(t, srvc.Download(1, folder, 0), "an active game cannot be queued again")
+
+	// a higher priority moves game 3 ahead of game 2
+	require.True(t, srvc.SetPriority(3, 5))
+	require.False(t, srvc.SetPriority(1, 5), "only queued games can be reordered")
+	require.Equal(t, []QueueEntry{
+		{GameId: 3, Priority: 5, Position: 0},
+		{GameId: 2, Priority: 0, Position: 1},
+	}, srvc.Queue())
+
+	require.Equal(t, StatusQueued, rec.last(2))
+	require.Equal(t, StatusQueued, rec.last(3))
+
+	close(release)
+	for _, gameId := range []int{1, 2, 3} {
+		require.Eventually(t, func() bool {
+			return rec.last(gameId) == StatusComplete
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	rec.mu.Lock()
+	require.Equal(t, []int{1, 3, 2}, rec.started)
+	rec.mu.Unlock()
+
+	require.Empty(t, srvc.Queue())
+	require.Eventually(t, func() bool {
+		return len(srvc.ActiveDownloads.Keys()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPauseQueued(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbb",
+	})
+	release := make(chan struct{})
+	fs.hold = func(r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/load/1/") {
+			<-release
+		}
+	}
+
+	rec := &gameStatuses{statuses: map[int][]Status{}}
+	srvc := &Service{
+		progress:                rec,
+		baseurl:                 srv.URL,
+		httpClient:              http.DefaultClient,
+		maxConcurrentFiles:      testConfig{}.getMaxConcurrentFiles(),
+		maxConcurrentFileChunks: testConfig{}.getMaxConcurrentFileChunks(),
+		chunkSize:               testConfig{}.getChunkSize(),
+		retryPolicy:             testConfig{}.getRetryPolicy(),
+		maxConcurrentGames:      1,
+	}
+
+	folder := t.TempDir()
+	require.NoError(t, srvc.Download(1, folder, 0))
+	require.NoError(t, srvc.Download(2, folder, 0))
+
+	// pausing a queued game only removes it from the queue
+	require.NoError(t, srvc.Pause(2))
+	require.Empty(t, srvc.Queue())
+
+	close(release)
+	require.Eventually(t, func() bool {
+		return rec.last(1) == StatusComplete
+	}, 10*time.Second, 10*time.Millisecond)
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	require.NotContains(t, rec.statuses[2], StatusMetadata)
+}
@@ -0,0 +1,88 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+type RetryPolicy struct {
+	// Budget is the number of retries shared by every chunk and file of a game
+	Budget   int
+	BaseWait time.Duration
+	MaxWait  time.Duration
+}
+
+func DefaultRetryPolicy(budget int) RetryPolicy {
+	return RetryPolicy{
+		Budget:   budget,
+		BaseWait: time.Second,
+		MaxWait:  time.Minute,
+	}
+}
+
+// backoff doubles the wait on every attempt up to MaxWait
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.BaseWait
+	for range attempt {
+		wait *= 2
+		if wait >= p.MaxWait {
+			return p.MaxWait
+		}
+	}
+	return wait
+}
+
+// retryBudget is consumed by every retry of a download
+type retryBudget struct {
+	policy RetryPolicy
+	used   atomic.Int64
+}
+
+// take reserves a retry, false once the budget is spent
+func (b *retryBudget) take() bool {
+	return b.used.Add(1) <= int64(b.policy.Budget)
+}
+
+// wait sleeps for the backoff of the attempt, false if the context is done first
+func (b *retryBudget) wait(ctx context.Context, attempt int) bool {
+	timer := time.NewTimer(b.policy.backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// httpStatusError is returned for error responses from glacier
+type httpStatusError struct {
+	code int
+	msg  string
+}
+
+func (e *httpStatusError) Error() string {
+	return e.msg
+}
+
+// retryable reports if trying again could fix the error,
+// client errors like a missing file will keep failing
+func retryable(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		code := statusErr.code
+		return code >= 500 || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+	}
+
+	return true
+}
@@ -0,0 +1,98 @@
+package download
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{BaseWait: time.Second, MaxWait: 5 * time.Second}
+	require.Equal(t, time.Second, p.backoff(0))
+	require.Equal(t, 2*time.Second, p.backoff(1))
+	require.Equal(t, 4*time.Second, p.backoff(2))
+	require.Equal(t, 5*time.Second, p.backoff(3))
+	require.Equal(t, 5*time.Second, p.backoff(60))
+}
+
+func TestRetryable(t *testing.T) {
+	require.True(t, retryable(errors.New("connection reset")))
+	require.True(t, retryable(&httpStatusError{code: http.StatusServiceUnavailable}))
+	require.True(t, retryable(&httpStatusError{code: http.StatusTooManyRequests}))
+	require.False(t, retryable(&httpStatusError{code: http.StatusNotFound}))
+}
+
+func TestChunkRetry(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbbcccc",
+	})
+
+	failures := 2
+	fs.intercept = func(w http.ResponseWriter, rel string) bool {
+		if failures == 0 {
+			return false
+		}
+		failures--
+		http.Error(w, "busy", http.StatusServiceUnavailable)
+		return true
+	}
+
+	folder := t.TempDir()
+	runDownload(t, srv, folder)
+
+	require.Len(t, fs.ranges["data.pak"], 3+2)
+	contents, err := os.ReadFile(filepath.Join(folder, "data.pak"))
+	require.NoError(t, err)
+	require.Equal(t, "aaaabbbbcccc", string(contents))
+}
+
+func TestRetryBudgetExhausted(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbbcccc",
+	})
+	fs.intercept = func(w http.ResponseWriter, rel string) bool {
+		http.Error(w, "down", http.StatusBadGateway)
+		return true
+	}
+
+	_, last := runDownloadUntilDone(t, srv, t.TempDir())
+	require.Equal(t, StatusError, last.Status)
+	// one try per chunk plus the shared budget
+	require.Len(t, fs.ranges["data.pak"], 3+3)
+}
+
+func TestChecksumMismatchRequeuesFile(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak":  "aaaabbbbcccc",
+		"other.pak": "other",
+	})
+
+	corrupted := false
+	fs.intercept = func(w http.ResponseWriter, rel string) bool {
+		if rel != "data.pak" || corrupted {
+			return false
+		}
+		corrupted = true
+		// right length, wrong bytes
+		w.Header().Set("Content-Range", "bytes 0-3/12")
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write([]byte("zzzz"))
+		return true
+	}
+
+	folder := t.TempDir()
+	runDownload(t, srv, folder)
+
+	// only the corrupted block of data.pak is fetched again
+	require.Len(t, fs.ranges["data.pak"], 3+1)
+	require.Len(t, fs.ranges["other.pak"], 2)
+
+	contents, err := os.ReadFile(filepath.Join(folder, "data.pak"))
+	require.NoError(t, err)
+	require.Equal(t, "aaaabbbbcccc", string(contents))
+}
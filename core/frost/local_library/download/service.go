@@ -6,9 +6,13 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"time"
 
 	hc "github.com/ra341/glacier/frost/http_client"
+	"github.com/ra341/glacier/internal/library"
+	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/ra341/glacier/pkg/pubsub"
 	"github.com/ra341/glacier/pkg/syncmap"
 )
 
@@ -23,8 +27,21 @@ type Service struct {
 	maxConcurrentFiles      int
 	maxConcurrentFileChunks int
 	chunkSize               int64
+	retryPolicy             RetryPolicy
+	maxConcurrentGames      int
+	throttle                *Throttle
+
+	queueMu  sync.Mutex
+	queue    []queueItem
+	queueSeq uint64
 
 	ActiveDownloads syncmap.Map[int, *Download]
+
+	events pubsub.Broker[ProgressEvent]
+	// called when a download completes or fails, set before downloads start
+	finished OnDone
+	// space of downloads that is not written yet
+	space *fileutil.SpaceReserver
 }
 
 // New
@@ -34,7 +51,8 @@ func New(
 	basepath string,
 	httpCliFactory hc.HttpCliFactory,
 	progress ProgressUpdater,
-	maxConcurrentFiles, maxConcurrentFileChunks int,
+	throttle *Throttle,
+	maxConcurrentGames, maxConcurrentFiles, maxConcurrentFileChunks, maxRetries int,
 ) *Service {
 	transport := &http.Transport{
 		// MaxIdleConns is the total connections across all hosts
@@ -56,24 +74,44 @@ func New(
 		progress:                progress,
 		maxConcurrentFiles:      maxConcurrentFiles,
 		maxConcurrentFileChunks: maxConcurrentFileChunks,
-		chunkSize:               MB * 128,
+		chunkSize:               library.BlockSize,
+		retryPolicy:             DefaultRetryPolicy(maxRetries),
+		maxConcurrentGames:      maxConcurrentGames,
+		throttle:                throttle,
+		space:                   fileutil.NewSpaceReserver(nil),
 	}
 }
 
-func (d *Service) Download(gameId int, downloadFolder string) error {
-	gameDownload := filepath.Join(downloadFolder, strconv.Itoa(gameId))
-	err := os.MkdirAll(gameDownload, 0755)
+// GamePath is the folder a game is downloaded into
+func GamePath(downloadFolder string, gameId int) string {
+	return filepath.Join(downloadFolder, strconv.Itoa(gameId))
+}
+
+// Download queues the game, it starts once a download slot is free
+func (d *Service) Download(gameId int, downloadFolder string, priority int) error {
+	return d.Resume(gameId, GamePath(downloadFolder, gameId), priority)
+}
+
+// Resume queues the game folder again,
+// chunks already in its cache are not downloaded again
+func (d *Service) Resume(gameId int, gamePath string, priority int) error {
+	return d.enqueue(gameId, gamePath, priority)
+}
+
+// start begins downloading right away, use enqueue to respect the slot limit
+func (d *Service) start(gameId int, gamePath string) error {
+	err := os.MkdirAll(gamePath, 0755)
 	if err != nil {
 		return err
 	}
 
-	// todo check for avail space
+	// free space is checked by the download once the manifest is known
 	download, err := NewDownload(
 		d,
 		d.onDone,
 		d.progress,
 		d.baseurl,
-		gameDownload,
+		gamePath,
 		gameId,
 	)
 	if err != nil {
@@ -84,8 +122,72 @@ func (d *Service) Download(gameId int, downloadFolder string) error {
 
 	return nil
 }
-func (d *Service) onDone(gameId int) {
+
+// Pause stops the download, keeping the downloaded chunks for Resume
+func (d *Service) Pause(gameId int) error {
+	if d.dequeue(gameId) {
+		return nil
+	}
+
+	download, ok := d.ActiveDownloads.LoadAndDelete(gameId)
+	if !ok || !download.Running() {
+		return fmt.Errorf("game %d is not downloading", gameId)
+	}
+
+	download.Stop()
+	d.schedule()
+	return nil
+}
+
+// Cancel stops the download if it is running and deletes the game folder
+func (d *Service) Cancel(gameId int, gamePath string) error {
+	d.dequeue(gameId)
+	if download, ok := d.ActiveDownloads.LoadAndDelete(gameId); ok {
+		download.Stop()
+		d.schedule()
+	}
+
+	if gamePath == "" {
+		return nil
+	}
+	return os.RemoveAll(gamePath)
+}
+
+// Watch streams the progress of every download,
+// call the returned func to stop watching
+func (d *Service) Watch() (<-chan ProgressEvent, func()) {
+	sub := d.events.Subscribe(256)
+	return sub.C, sub.Close
+}
+
+// Throttle limits the speed of every download, its limits can be changed while downloading
+func (d *Service) Throttle() *Throttle {
+	return d.throttle
+}
+
+// OnFinished sets the func called when a download completes or fails,
+// unlike Watch it never misses a download
+func (d *Service) OnFinished(fn OnDone) {
+	d.finished = fn
+}
+
+func (d *Service) onDone(gameId int, status Status) {
+	// start stores the download under queueMu, a download that ends
+	// right away must not be removed before it was stored
+	d.queueMu.Lock()
 	d.ActiveDownloads.Delete(gameId)
+	d.queueMu.Unlock()
+
+	if status == StatusComplete || status == StatusError {
+		d.finish(gameId, status)
+	}
+	d.schedule()
+}
+
+func (d *Service) finish(gameId int, status Status) {
+	if d.finished != nil {
+		d.finished(gameId, status)
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -106,3 +208,19 @@ func (d *Service) getMaxConcurrentFileChunks() int {
 func (d *Service) getHttpClient() *http.Client {
 	return d.httpClient
 }
+
+func (d *Service) getRetryPolicy() RetryPolicy {
+	return d.retryPolicy
+}
+
+func (d *Service) getThrottle() *Throttle {
+	return d.throttle
+}
+
+func (d *Service) getEvents() *pubsub.Broker[ProgressEvent] {
+	return &d.events
+}
+
+func (d *Service) getSpace() *fileutil.SpaceReserver {
+	return d.space
+}
@@ -0,0 +1,40 @@
+package download
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotEnoughSpace(t *testing.T) {
+	srv, fs := newFakeServer(t, map[string]string{
+		"data.pak": "aaaabbbbcccc",
+	})
+
+	rec := &gameStatuses{statuses: map[int][]Status{}}
+	srvc := &Service{
+		progress:                rec,
+		baseurl:                 srv.URL,
+		httpClient:              testConfig{}.getHttpClient(),
+		maxConcurrentFiles:      testConfig{}.getMaxConcurrentFiles(),
+		maxConcurrentFileChunks: testConfig{}.getMaxConcurrentFileChunks(),
+		chunkSize:               testConfig{}.getChunkSize(),
+		retryPolicy:             testConfig{}.getRetryPolicy(),
+		space: fileutil.NewSpaceReserver(func(string) (uint64, error) {
+			return 8, nil
+		}),
+	}
+
+	require.NoError(t, srvc.Download(1, t.TempDir(), 0))
+	require.Eventually(t, func() bool {
+		return rec.last(1) == StatusError
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// fails before any file is downloaded and frees the reservation
+	require.Empty(t, fs.fetched)
+	require.Eventually(t, func() bool {
+		return srvc.space.Reserved() == 0 && len(srvc.ActiveDownloads.Keys()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
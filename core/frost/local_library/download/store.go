@@ -14,8 +14,13 @@ const (
 	StatusQueued Status = iota
 	StatusMetadata
 	StatusDownloading
+	// StatusUpdating is a download over a previous install,
+	// only the files changed on the server are fetched
+	StatusUpdating
 	StatusError
 	StatusComplete
+	// StatusPaused keeps the downloaded chunks until the download is resumed
+	StatusPaused
 )
 
 type ChunkState int
@@ -37,9 +42,13 @@ type CacheStore interface {
 	GetFileList() ([]string, error)
 	GetChunkLen(file string) (int, error)
 
-	Add(file string, chunk []Chunk) error
+	Add(file string, checksum string, chunk []Chunk) error
 	Get(file string) ([]Chunk, bool, error)
 	Update(file string, index int, chunk *Chunk) error
+	Remove(file string) error
+
+	GetChecksum(file string) (string, bool, error)
+	SetChecksum(file string, checksum string) error
 	Progress() (progress []FileProgress, err error)
 }
 
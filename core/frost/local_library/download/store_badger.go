@@ -107,12 +107,21 @@ func (c *CacheStoreBadger) GetChunkLen(file string) (int, error) {
 	return count, err
 }
 
-// Add initializes the file entry and all its chunks
-func (c *CacheStoreBadger) Add(file string, chunks []Chunk) error {
+// fileEntry is stored under the file key
+type fileEntry struct {
+	// checksum of the file on the server when the chunks were created
+	Checksum string `json:"checksum"`
+}
+
+// Add initializes the file entry and all its chunks, replacing any previous chunks
+func (c *CacheStoreBadger) Add(file string, checksum string, chunks []Chunk) error {
 	return c.db.Update(func(txn *badger.Txn) error {
+		if err := deleteChunks(txn, file); err != nil {
+			return err
+		}
+
 		// mark the file as existing
-		fileKey := []byte(fmt.Sprintf("f:%s", file))
-		if err := txn.Set(fileKey, []byte{1}); err != nil {
+		if err := setFileEntry(txn, file, checksum); err != nil {
 			return err
 		}
 
@@ -129,6 +138,75 @@ func (c *CacheStoreBadger) Add(file string, chunks []Chunk) error {
 	})
 }
 
+// GetChecksum returns the checksum the file was downloaded with,
+// files added by older versions have an empty checksum
+func (c *CacheStoreBadger) GetChecksum(file string) (checksum string, found bool, err error) {
+	err = c.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(fmt.Sprintf("f:%s", file)))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+
+		return item.Value(func(val []byte) error {
+			var entry fileEntry
+			// older entries only hold a marker byte
+			if json.Unmarshal(val, &entry) == nil {
+				checksum = entry.Checksum
+			}
+			return nil
+		})
+	})
+	return checksum, found, err
+}
+
+func (c *CacheStoreBadger) SetChecksum(file string, checksum string) error {
+	return c.db.Update(func(txn *badger.Txn) error {
+		return setFileEntry(txn, file, checksum)
+	})
+}
+
+// Remove deletes the file entry and its chunks
+func (c *CacheStoreBadger) Remove(file string) error {
+	return c.db.Update(func(txn *badger.Txn) error {
+		if err := deleteChunks(txn, file); err != nil {
+			return err
+		}
+		return txn.Delete([]byte(fmt.Sprintf("f:%s", file)))
+	})
+}
+
+func setFileEntry(txn *badger.Txn, file string, checksum string) error {
+	data, err := json.Marshal(fileEntry{Checksum: checksum})
+	if err != nil {
+		return err
+	}
+	return txn.Set([]byte(fmt.Sprintf("f:%s", file)), data)
+}
+
+func deleteChunks(txn *badger.Txn, file string) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	var keys [][]byte
+	prefix := []byte(fmt.Sprintf("c:%s:", file))
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		keys = append(keys, it.Item().KeyCopy(nil))
+	}
+
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Get retrieves all chunks for a specific file in order
 func (c *CacheStoreBadger) Get(file string) (chunks []Chunk, found bool, err error) {
 	err = c.db.View(func(txn *badger.Txn) error {
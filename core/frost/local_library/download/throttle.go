@@ -0,0 +1,216 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const KB = 1024
+
+// throttleBurst is the most read from a response before waiting on the limiters,
+// the same size io.Copy uses for its buffer
+const throttleBurst = 32 * KB
+
+// Bandwidth limits are in bytes per second, 0 is unlimited
+type Bandwidth struct {
+	// Limit is shared by every download outside the schedule
+	Limit int64
+	// GameLimit applies to each download on its own
+	GameLimit int64
+	// Schedule overrides Limit while a window is active, the first matching window wins
+	Schedule []SpeedWindow
+}
+
+// SpeedWindow is a daily time range with its own limit,
+// a window ending before it starts runs past midnight
+type SpeedWindow struct {
+	// minutes since midnight
+	Start int
+	End   int
+	Limit int64
+}
+
+func (w SpeedWindow) contains(minute int) bool {
+	if w.Start == w.End {
+		// whole day
+		return true
+	}
+	if w.Start < w.End {
+		return minute >= w.Start && minute < w.End
+	}
+	return minute >= w.Start || minute < w.End
+}
+
+func (w SpeedWindow) String() string {
+	return fmt.Sprintf("%s-%s=%d", FormatClock(w.Start), FormatClock(w.End), w.Limit/KB)
+}
+
+// ParseSchedule reads windows like "00:00-07:00=0,18:00-23:00=2048" with limits in KB/s,
+// "none" or an empty string is no schedule
+func ParseSchedule(schedule string) ([]SpeedWindow, error) {
+	schedule = strings.TrimSpace(schedule)
+	if schedule == "" || schedule == "none" {
+		return nil, nil
+	}
+
+	var windows []SpeedWindow
+	for _, part := range strings.Split(schedule, ",") {
+		part = strings.TrimSpace(part)
+		span, limit, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid schedule window %q, expected start-end=limit", part)
+		}
+		start, end, ok := strings.Cut(span, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid schedule window %q, expected start-end=limit", part)
+		}
+
+		var (
+			window SpeedWindow
+			err    error
+		)
+		window.Start, err = ParseClock(start)
+		if err != nil {
+			return nil, err
+		}
+		window.End, err = ParseClock(end)
+		if err != nil {
+			return nil, err
+		}
+		limitKB, err := strconv.ParseInt(strings.TrimSpace(limit), 10, 64)
+		if err != nil || limitKB < 0 {
+			return nil, fmt.Errorf("invalid limit in schedule window %q", part)
+		}
+		window.Limit = limitKB * KB
+
+		windows = append(windows, window)
+	}
+
+	return windows, nil
+}
+
+// ParseClock converts "HH:MM" to minutes since midnight
+func ParseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(clock))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FormatClock converts minutes since midnight to "HH:MM"
+func FormatClock(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// Throttle limits the speed of all downloads and of each download,
+// a nil Throttle does not limit anything
+type Throttle struct {
+	mu        sync.RWMutex
+	bandwidth Bandwidth
+
+	global *rate.Limiter
+	now    func() time.Time
+}
+
+func NewThrottle(bandwidth Bandwidth) *Throttle {
+	return &Throttle{
+		bandwidth: bandwidth,
+		global:    rate.NewLimiter(rate.Inf, throttleBurst),
+		now:       time.Now,
+	}
+}
+
+func (t *Throttle) Get() Bandwidth {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.bandwidth
+}
+
+// Set changes the limits, running downloads pick them up on their next read
+func (t *Throttle) Set(bandwidth Bandwidth) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.bandwidth = bandwidth
+}
+
+// Current is the limit shared by all downloads right now
+func (t *Throttle) Current() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	now := t.now()
+	minute := now.Hour()*60 + now.Minute()
+	for _, w := range t.bandwidth.Schedule {
+		if w.contains(minute) {
+			return w.Limit
+		}
+	}
+	return t.bandwidth.Limit
+}
+
+// newGameLimiter is used by a single download for GameLimit
+func (t *Throttle) newGameLimiter() *rate.Limiter {
+	if t == nil {
+		return nil
+	}
+	return rate.NewLimiter(rate.Inf, throttleBurst)
+}
+
+// reader wraps a response body so reads wait on the game and global limiters
+func (t *Throttle) reader(ctx context.Context, r io.Reader, game *rate.Limiter) io.Reader {
+	if t == nil {
+		return r
+	}
+	return &throttledReader{ctx: ctx, r: r, throttle: t, game: game}
+}
+
+func (t *Throttle) wait(ctx context.Context, game *rate.Limiter, n int) error {
+	setLimit(t.global, t.Current())
+	setLimit(game, t.Get().GameLimit)
+
+	err := game.WaitN(ctx, n)
+	if err != nil {
+		return err
+	}
+	return t.global.WaitN(ctx, n)
+}
+
+func setLimit(limiter *rate.Limiter, bytesPerSec int64) {
+	limit := rate.Inf
+	if bytesPerSec > 0 {
+		limit = rate.Limit(bytesPerSec)
+	}
+	if limiter.Limit() != limit {
+		limiter.SetLimit(limit)
+	}
+}
+
+type throttledReader struct {
+	ctx      context.Context
+	r        io.Reader
+	throttle *Throttle
+	game     *rate.Limiter
+}
+
+func (tr *throttledReader) Read(p []byte) (int, error) {
+	// WaitN fails for more than the burst
+	if len(p) > throttleBurst {
+		p = p[:throttleBurst]
+	}
+
+	n, err := tr.r.Read(p)
+	if n > 0 {
+		if waitErr := tr.throttle.wait(tr.ctx, tr.game, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,75 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	windows, err := ParseSchedule("00:00-07:00=0, 22:30-01:00=5120")
+	require.NoError(t, err)
+	require.Equal(t, []SpeedWindow{
+		{Start: 0, End: 7 * 60, Limit: 0},
+		{Start: 22*60 + 30, End: 60, Limit: 5120 * KB},
+	}, windows)
+	require.Equal(t, "22:30-01:00=5120", windows[1].String())
+
+	windows, err = ParseSchedule("none")
+	require.NoError(t, err)
+	require.Empty(t, windows)
+
+	for _, invalid := range []string{"00:00=5", "00:00-25:00=5", "00:00-07:00=fast", "00:00-07:00=-1"} {
+		_, err = ParseSchedule(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestThrottleSchedule(t *testing.T) {
+	schedule, err := ParseSchedule("00:00-07:00=0,22:00-02:00=100")
+	require.NoError(t, err)
+
+	throttle := NewThrottle(Bandwidth{Limit: 5 * MB, Schedule: schedule})
+	at := func(clock string) int64 {
+		parsed, err := time.Parse("15:04", clock)
+		require.NoError(t, err)
+		throttle.now = func() time.Time { return parsed }
+		return throttle.Current()
+	}
+
+	// the first matching window wins
+	require.Equal(t, int64(0), at("01:00"))
+	require.Equal(t, int64(0), at("06:59"))
+	require.Equal(t, int64(5*MB), at("07:00"))
+	require.Equal(t, int64(100*KB), at("23:00"))
+	require.Equal(t, int64(5*MB), at("12:00"))
+}
+
+func TestThrottledReader(t *testing.T) {
+	throttle := NewThrottle(Bandwidth{GameLimit: 64 * KB})
+	data := bytes.Repeat([]byte("a"), 64*KB)
+
+	start := time.Now()
+	read, err := io.ReadAll(throttle.reader(context.Background(), bytes.NewReader(data), throttle.newGameLimiter()))
+	require.NoError(t, err)
+	require.Equal(t, data, read)
+	// the first burst is free, the rest takes about half a second
+	require.Greater(t, time.Since(start), 400*time.Millisecond)
+
+	// removing the limit applies to the next reads
+	throttle.Set(Bandwidth{})
+	start = time.Now()
+	_, err = io.Copy(io.Discard, throttle.reader(context.Background(), bytes.NewReader(bytes.Repeat(data, 16)), throttle.newGameLimiter()))
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+
+	// downloads without a throttle read the body directly
+	var none *Throttle
+	body := bytes.NewReader(data)
+	require.Nil(t, none.newGameLimiter())
+	require.Same(t, body, none.reader(context.Background(), body, nil))
+}
@@ -0,0 +1,208 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	v1 "github.com/ra341/glacier/generated/frost_library/v1"
+	"github.com/ra341/glacier/internal/library"
+	"github.com/ra341/glacier/pkg/fileutil"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// VerifyReport is the difference between the game folder and the server manifest,
+// paths are relative to the game folder
+type VerifyReport struct {
+	Missing  []string
+	Modified []string
+	// files that are not in the manifest, they are reported but never removed
+	Extra []string
+	// files that match the manifest
+	Verified int
+}
+
+// Ok is true when no file has to be downloaded again
+func (r *VerifyReport) Ok() bool {
+	return len(r.Missing) == 0 && len(r.Modified) == 0
+}
+
+func (r *VerifyReport) ToProto() *v1.VerifyReport {
+	return &v1.VerifyReport{
+		Missing:  r.Missing,
+		Modified: r.Modified,
+		Extra:    r.Extra,
+		Verified: int64(r.Verified),
+	}
+}
+
+// Verify hashes every file of the game folder and compares it with the server manifest
+func (d *Service) Verify(ctx context.Context, gameId int, gamePath string) (*VerifyReport, error) {
+	if d.busy(gameId) {
+		return nil, fmt.Errorf("game %d is downloading", gameId)
+	}
+
+	meta, err := fetchManifest(ctx, d.httpClient, metadataUrl(d.baseurl, gameId))
+	if err != nil {
+		return nil, fmt.Errorf("could not get manifest: %w", err)
+	}
+
+	return verifyFolder(ctx, gamePath, &meta, d.maxConcurrentFiles)
+}
+
+// Repair queues a download of the missing and modified files of the report,
+// blocks of a modified file that still match the manifest are reused
+func (d *Service) Repair(gameId int, gamePath string, report *VerifyReport, priority int) error {
+	if d.busy(gameId) {
+		return fmt.Errorf("game %d is downloading", gameId)
+	}
+	if report.Ok() {
+		return nil
+	}
+
+	err := invalidateFiles(gamePath, report.Modified)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Int("game", gameId).
+		Int("missing", len(report.Missing)).
+		Int("modified", len(report.Modified)).
+		Msg("repairing game")
+	return d.Resume(gameId, gamePath, priority)
+}
+
+// busy is true while the game is queued or downloading
+func (d *Service) busy(gameId int) bool {
+	if _, active := d.ActiveDownloads.Load(gameId); active {
+		return true
+	}
+
+	d.queueMu.Lock()
+	defer d.queueMu.Unlock()
+	return d.queueIndex(gameId) >= 0
+}
+
+// invalidateFiles clears the cached checksum of the files,
+// diff then hashes them again instead of trusting the cache
+func invalidateFiles(gamePath string, relPaths []string) error {
+	cache, err := NewCacheStoreBadger(filepath.Join(gamePath, MetadataFolder))
+	if err != nil {
+		return fmt.Errorf("could not open download cache: %w", err)
+	}
+	defer fileutil.Close(cache)
+
+	for _, rel := range relPaths {
+		fullPath := filepath.Join(gamePath, rel)
+		_, found, err := cache.GetChecksum(fullPath)
+		if err != nil {
+			return err
+		}
+		if !found {
+			// not cached, diff downloads it from scratch
+			continue
+		}
+
+		err = cache.SetChecksum(fullPath, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifyFolder(ctx context.Context, gamePath string, meta *library.FolderManifest, workers int) (*VerifyReport, error) {
+	report := &VerifyReport{}
+	var mu sync.Mutex
+	add := func(list *[]string, rel string) {
+		mu.Lock()
+		defer mu.Unlock()
+		*list = append(*list, rel)
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	if workers > 0 {
+		eg.SetLimit(workers)
+	}
+
+	upstream := make(map[string]struct{}, len(meta.FileInfo))
+	for _, fm := range meta.FileInfo {
+		upstream[filepath.Clean(fm.RelPath)] = struct{}{}
+
+		eg.Go(func() error {
+			if err := egCtx.Err(); err != nil {
+				return err
+			}
+
+			fullPath := filepath.Join(gamePath, fm.RelPath)
+			stat, err := os.Stat(fullPath)
+			if errors.Is(err, fs.ErrNotExist) {
+				add(&report.Missing, fm.RelPath)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// a different size is modified without reading the file
+			if stat.Size() != fm.Size {
+				add(&report.Modified, fm.RelPath)
+				return nil
+			}
+
+			hash, err := library.GetHash(fullPath)
+			if err != nil {
+				return fmt.Errorf("could not hash %s: %w", fm.RelPath, err)
+			}
+			if hash != fm.Checksum {
+				add(&report.Modified, fm.RelPath)
+				return nil
+			}
+
+			mu.Lock()
+			report.Verified++
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	eg.Go(func() error {
+		return filepath.WalkDir(gamePath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if d.Name() == MetadataFolder {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			rel, err := filepath.Rel(gamePath, path)
+			if err != nil {
+				return err
+			}
+			if _, ok := upstream[rel]; !ok {
+				add(&report.Extra, rel)
+			}
+			return nil
+		})
+	})
+
+	err := eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(report.Missing)
+	slices.Sort(report.Modified)
+	slices.Sort(report.Extra)
+	return report, nil
+}
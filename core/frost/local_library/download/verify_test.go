@@ -0,0 +1,58 @@
+package download
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAndRepair(t *testing.T) {
+	files := map[string]string{
+		"game.exe":       "aaaabbbbccccdddd",
+		"data/level.pak": "level one",
+		"readme.txt":     "hi",
+	}
+	srv, fs := newFakeServer(t, files)
+	folder := t.TempDir()
+	runDownload(t, srv, folder)
+
+	verify := func() *VerifyReport {
+		meta, err := fetchManifest(context.Background(), http.DefaultClient, metadataUrl(srv.URL, 1))
+		require.NoError(t, err)
+		report, err := verifyFolder(context.Background(), folder, &meta, 2)
+		require.NoError(t, err)
+		return report
+	}
+
+	report := verify()
+	require.True(t, report.Ok())
+	require.Equal(t, &VerifyReport{Verified: 3}, report)
+
+	// same size with a corrupted block, a deleted file and a file the user added
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "game.exe"), []byte("aaaaXXXXccccdddd"), 0644))
+	require.NoError(t, os.Remove(filepath.Join(folder, "data", "level.pak")))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "settings.ini"), []byte("fov=90"), 0644))
+
+	report = verify()
+	require.False(t, report.Ok())
+	require.Equal(t, &VerifyReport{
+		Missing:  []string{filepath.Join("data", "level.pak")},
+		Modified: []string{"game.exe"},
+		Extra:    []string{"settings.ini"},
+		Verified: 1,
+	}, report)
+
+	// the cache still trusts the corrupted file, without invalidating nothing is fetched for it
+	require.NoError(t, invalidateFiles(folder, report.Modified))
+	fs.set(files)
+	runDownload(t, srv, folder)
+
+	require.Equal(t, map[string]bool{"game.exe": true, "data/level.pak": true}, fs.fetched)
+	require.Equal(t, []string{"bytes=4-7"}, fs.ranges["game.exe"])
+	require.True(t, verify().Ok())
+	require.FileExists(t, filepath.Join(folder, "settings.ini"))
+}
@@ -2,10 +2,15 @@ package download
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/ra341/glacier/frost/local_library/download"
+	"github.com/ra341/glacier/frost/local_library/install"
+	"github.com/ra341/glacier/frost/local_library/play"
+	"github.com/ra341/glacier/frost/local_library/runner"
 	v1 "github.com/ra341/glacier/generated/frost_library/v1"
 	"github.com/ra341/glacier/generated/frost_library/v1/v1connect"
 	"github.com/ra341/glacier/pkg/listutils"
@@ -43,7 +48,7 @@ func NewHandler(srv *Service) (string, http.Handler) {
 }
 
 func (h *Handler) Download(ctx context.Context, c *connect.Request[v1.DownloadRequest]) (*connect.Response[v1.DownloadResponse], error) {
-	err := h.srv.Download(ctx, int(c.Msg.GameId), c.Msg.DownloadFolder)
+	err := h.srv.Download(ctx, int(c.Msg.GameId), c.Msg.DownloadFolder, int(c.Msg.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +56,318 @@ func (h *Handler) Download(ctx context.Context, c *connect.Request[v1.DownloadRe
 	return &connect.Response[v1.DownloadResponse]{}, nil
 }
 
+func (h *Handler) Pause(ctx context.Context, c *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error) {
+	err := h.srv.Pause(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.PauseResponse{}), nil
+}
+
+func (h *Handler) Resume(ctx context.Context, c *connect.Request[v1.ResumeRequest]) (*connect.Response[v1.ResumeResponse], error) {
+	err := h.srv.Resume(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ResumeResponse{}), nil
+}
+
+func (h *Handler) Cancel(ctx context.Context, c *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error) {
+	err := h.srv.Cancel(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CancelResponse{}), nil
+}
+
+func (h *Handler) SetPriority(ctx context.Context, c *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error) {
+	err := h.srv.SetPriority(ctx, int(c.Msg.GameId), int(c.Msg.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetPriorityResponse{}), nil
+}
+
+func (h *Handler) ListQueue(ctx context.Context, c *connect.Request[v1.ListQueueRequest]) (*connect.Response[v1.ListQueueResponse], error) {
+	res := listutils.ToMap(h.srv.ListQueue(), func(t download.QueueEntry) *v1.QueueEntry {
+		return &v1.QueueEntry{
+			GameId:   int64(t.GameId),
+			Priority: int32(t.Priority),
+			Position: uint32(t.Position),
+		}
+	})
+
+	return connect.NewResponse(&v1.ListQueueResponse{
+		Entries: res,
+	}), nil
+}
+
+func (h *Handler) Verify(ctx context.Context, c *connect.Request[v1.VerifyRequest]) (*connect.Response[v1.VerifyResponse], error) {
+	report, err := h.srv.Verify(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.VerifyResponse{
+		Report: report.ToProto(),
+	}), nil
+}
+
+func (h *Handler) Repair(ctx context.Context, c *connect.Request[v1.RepairRequest]) (*connect.Response[v1.RepairResponse], error) {
+	report, err := h.srv.Repair(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.RepairResponse{
+		Report: report.ToProto(),
+	}), nil
+}
+
+func (h *Handler) WatchProgress(ctx context.Context, c *connect.Request[v1.WatchProgressRequest], stream *connect.ServerStream[v1.WatchProgressResponse]) error {
+	wanted := make(map[int]bool, len(c.Msg.GameIds))
+	for _, id := range c.Msg.GameIds {
+		wanted[int(id)] = true
+	}
+
+	events, unsubscribe := h.srv.WatchProgress()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if len(wanted) > 0 && !wanted[event.GameId] {
+				continue
+			}
+
+			err := stream.Send(&v1.WatchProgressResponse{
+				GameId:        int64(event.GameId),
+				Status:        event.Status.String(),
+				StatusMessage: event.StatusMessage,
+				File:          event.File,
+				FileComplete:  event.FileComplete,
+				FileSize:      event.FileSize,
+				Complete:      event.Complete,
+				Total:         event.Total,
+				BytesPerSec:   event.BytesPerSec,
+				EtaSeconds:    int64(event.ETA.Seconds()),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *Handler) ListInstallers(ctx context.Context, c *connect.Request[v1.ListInstallersRequest]) (*connect.Response[v1.ListInstallersResponse], error) {
+	candidates, err := h.srv.ListInstallers(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	res := listutils.ToMap(candidates, func(t install.Candidate) *v1.Installer {
+		return &v1.Installer{
+			Path: t.RelPath,
+			Kind: t.Kind.String(),
+		}
+	})
+
+	return connect.NewResponse(&v1.ListInstallersResponse{
+		Installers: res,
+	}), nil
+}
+
+func (h *Handler) Install(ctx context.Context, c *connect.Request[v1.InstallRequest]) (*connect.Response[v1.InstallResponse], error) {
+	err := h.srv.Install(ctx, int(c.Msg.GameId), c.Msg.InstallerPath, c.Msg.InstallDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.InstallResponse{}), nil
+}
+
+func (h *Handler) CancelInstall(ctx context.Context, c *connect.Request[v1.CancelInstallRequest]) (*connect.Response[v1.CancelInstallResponse], error) {
+	err := h.srv.CancelInstall(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CancelInstallResponse{}), nil
+}
+
+func (h *Handler) GetInstallLog(ctx context.Context, c *connect.Request[v1.GetInstallLogRequest]) (*connect.Response[v1.GetInstallLogResponse], error) {
+	installLog, err := h.srv.InstallLog(int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.GetInstallLogResponse{
+		Log: installLog,
+	}), nil
+}
+
+func (h *Handler) SetLaunchOptions(ctx context.Context, c *connect.Request[v1.SetLaunchOptionsRequest]) (*connect.Response[v1.SetLaunchOptionsResponse], error) {
+	err := h.srv.SetLaunchOptions(ctx, int(c.Msg.GameId), &GamePlay{
+		ExePath: c.Msg.ExePath,
+		Args:    c.Msg.Args,
+		Env:     c.Msg.Env,
+		WorkDir: c.Msg.WorkDir,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetLaunchOptionsResponse{}), nil
+}
+
+func (h *Handler) Launch(ctx context.Context, c *connect.Request[v1.LaunchRequest]) (*connect.Response[v1.LaunchResponse], error) {
+	err := h.srv.Launch(ctx, int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.LaunchResponse{}), nil
+}
+
+func (h *Handler) ListRunners(ctx context.Context, c *connect.Request[v1.ListRunnersRequest]) (*connect.Response[v1.ListRunnersResponse], error) {
+	res := listutils.ToMap(h.srv.ListRunners(), func(r runner.Runner) *v1.Runner {
+		return &v1.Runner{
+			Kind:    r.Kind.String(),
+			Name:    r.Name,
+			Path:    r.Path,
+			Version: r.Version,
+		}
+	})
+
+	return connect.NewResponse(&v1.ListRunnersResponse{
+		Runners: res,
+	}), nil
+}
+
+func (h *Handler) SetRunner(ctx context.Context, c *connect.Request[v1.SetRunnerRequest]) (*connect.Response[v1.SetRunnerResponse], error) {
+	kind, err := runner.KindString(c.Msg.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.srv.SetRunner(ctx, int(c.Msg.GameId), runner.Config{
+		RunnerKind: kind,
+		RunnerPath: c.Msg.Path,
+		PrefixPath: c.Msg.PrefixPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetRunnerResponse{}), nil
+}
+
+func (h *Handler) StopGame(ctx context.Context, c *connect.Request[v1.StopGameRequest]) (*connect.Response[v1.StopGameResponse], error) {
+	err := h.srv.StopGame(int(c.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.StopGameResponse{}), nil
+}
+
+func (h *Handler) ListRunning(ctx context.Context, c *connect.Request[v1.ListRunningRequest]) (*connect.Response[v1.ListRunningResponse], error) {
+	res := listutils.ToMap(h.srv.ListRunning(), func(t int) int64 {
+		return int64(t)
+	})
+
+	return connect.NewResponse(&v1.ListRunningResponse{
+		GameIds: res,
+	}), nil
+}
+
+func (h *Handler) ListSessions(ctx context.Context, c *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	limit := int(c.Msg.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+
+	sessions, total, err := h.srv.ListSessions(ctx, int(c.Msg.GameId), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := listutils.ToMap(sessions, func(t play.Session) *v1.PlaySession {
+		return &v1.PlaySession{
+			Started:  t.Started.Format(time.RFC3339),
+			Ended:    t.Ended.Format(time.RFC3339),
+			Seconds:  t.Seconds,
+			ExitCode: int32(t.ExitCode),
+		}
+	})
+
+	return connect.NewResponse(&v1.ListSessionsResponse{
+		Sessions:     res,
+		TotalSeconds: total,
+	}), nil
+}
+
+func (h *Handler) GetBandwidth(ctx context.Context, c *connect.Request[v1.GetBandwidthRequest]) (*connect.Response[v1.GetBandwidthResponse], error) {
+	bandwidth, current := h.srv.GetBandwidth()
+
+	schedule := listutils.ToMap(bandwidth.Schedule, func(t download.SpeedWindow) *v1.SpeedWindow {
+		return &v1.SpeedWindow{
+			Start:   download.FormatClock(t.Start),
+			End:     download.FormatClock(t.End),
+			LimitKb: t.Limit / download.KB,
+		}
+	})
+
+	return connect.NewResponse(&v1.GetBandwidthResponse{
+		Bandwidth: &v1.Bandwidth{
+			LimitKb:     bandwidth.Limit / download.KB,
+			GameLimitKb: bandwidth.GameLimit / download.KB,
+			Schedule:    schedule,
+		},
+		CurrentLimitKb: current / download.KB,
+	}), nil
+}
+
+func (h *Handler) SetBandwidth(ctx context.Context, c *connect.Request[v1.SetBandwidthRequest]) (*connect.Response[v1.SetBandwidthResponse], error) {
+	rpcBandwidth := c.Msg.GetBandwidth()
+	if rpcBandwidth.GetLimitKb() < 0 || rpcBandwidth.GetGameLimitKb() < 0 {
+		return nil, fmt.Errorf("speed limits cannot be negative")
+	}
+
+	bandwidth := download.Bandwidth{
+		Limit:     rpcBandwidth.GetLimitKb() * download.KB,
+		GameLimit: rpcBandwidth.GetGameLimitKb() * download.KB,
+	}
+	for _, w := range rpcBandwidth.GetSchedule() {
+		start, err := download.ParseClock(w.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := download.ParseClock(w.End)
+		if err != nil {
+			return nil, err
+		}
+		if w.LimitKb < 0 {
+			return nil, fmt.Errorf("speed limit of window %s-%s cannot be negative", w.Start, w.End)
+		}
+
+		bandwidth.Schedule = append(bandwidth.Schedule, download.SpeedWindow{
+			Start: start,
+			End:   end,
+			Limit: w.LimitKb * download.KB,
+		})
+	}
+
+	h.srv.SetBandwidth(bandwidth)
+	return connect.NewResponse(&v1.SetBandwidthResponse{}), nil
+}
+
 func (h *Handler) ListDownloading(ctx context.Context, c *connect.Request[v1.ListDownloadingRequest]) (*connect.Response[v1.ListDownloadingResponse], error) {
 	games, err := h.srv.ListDownloading(ctx)
 	if err != nil {
@@ -0,0 +1,114 @@
+package install
+
+import (
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+//go:generate go run github.com/dmarkham/enumer@latest -type=Kind -trimprefix=Kind -output=enum_install_kind.go
+type Kind int
+
+const (
+	KindExe Kind = iota
+	KindMsi
+	KindShell
+	KindAppImage
+)
+
+// Candidate is a file in the game folder that looks like an installer
+type Candidate struct {
+	// relative to the game folder
+	RelPath string
+	Kind    Kind
+	// lower is more likely the installer
+	rank int
+}
+
+// installer names, in the order they are usually the right pick
+var installerNames = []string{"setup", "install", "installer"}
+
+// maxDepth keeps detection out of the game data, installers sit near the top
+const maxDepth = 3
+
+// Detect lists installer candidates in the game folder, the most likely first
+func Detect(gamePath string, skipDirs ...string) ([]Candidate, error) {
+	var candidates []Candidate
+
+	err := filepath.WalkDir(gamePath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(gamePath, path)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if slices.Contains(skipDirs, d.Name()) || strings.Count(rel, string(filepath.Separator)) >= maxDepth {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		candidate, ok := classify(rel)
+		if ok {
+			candidates = append(candidates, candidate)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(candidates, func(a, b Candidate) int {
+		if a.rank != b.rank {
+			return a.rank - b.rank
+		}
+		return strings.Compare(a.RelPath, b.RelPath)
+	})
+
+	return candidates, nil
+}
+
+func classify(rel string) (Candidate, bool) {
+	name := strings.ToLower(filepath.Base(rel))
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+
+	candidate := Candidate{RelPath: rel}
+	switch ext {
+	case ".appimage":
+		// AppImages are either the installer or the game, both are run the same way
+		candidate.Kind = KindAppImage
+		candidate.rank = 2
+		return candidate, true
+	case ".msi":
+		candidate.Kind = KindMsi
+		candidate.rank = 1
+		return candidate, true
+	case ".exe":
+		candidate.Kind = KindExe
+	case ".sh":
+		candidate.Kind = KindShell
+	default:
+		return candidate, false
+	}
+
+	for i, installer := range installerNames {
+		if stem == installer {
+			candidate.rank = i
+			return candidate, true
+		}
+	}
+	for _, installer := range installerNames {
+		if strings.Contains(stem, installer) {
+			candidate.rank = len(installerNames)
+			return candidate, true
+		}
+	}
+
+	return candidate, false
+}
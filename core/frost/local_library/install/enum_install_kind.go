@@ -0,0 +1,86 @@
+// Code generated by "enumer -type=Kind -trimprefix=Kind -output=enum_install_kind.go"; DO NOT EDIT.
+
+package install
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _KindName = "ExeMsiShellAppImage"
+
+var _KindIndex = [...]uint8{0, 3, 6, 11, 19}
+
+const _KindLowerName = "exemsishellappimage"
+
+func (i Kind) String() string {
+	if i < 0 || i >= Kind(len(_KindIndex)-1) {
+		return fmt.Sprintf("Kind(%d)", i)
+	}
+	return _KindName[_KindIndex[i]:_KindIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _KindNoOp() {
+	var x [1]struct{}
+	_ = x[KindExe-(0)]
+	_ = x[KindMsi-(1)]
+	_ = x[KindShell-(2)]
+	_ = x[KindAppImage-(3)]
+}
+
+var _KindValues = []Kind{KindExe, KindMsi, KindShell, KindAppImage}
+
+var _KindNameToValueMap = map[string]Kind{
+	_KindName[0:3]:        KindExe,
+	_KindLowerName[0:3]:   KindExe,
+	_KindName[3:6]:        KindMsi,
+	_KindLowerName[3:6]:   KindMsi,
+	_KindName[6:11]:       KindShell,
+	_KindLowerName[6:11]:  KindShell,
+	_KindName[11:19]:      KindAppImage,
+	_KindLowerName[11:19]: KindAppImage,
+}
+
+var _KindNames = []string{
+	_KindName[0:3],
+	_KindName[3:6],
+	_KindName[6:11],
+	_KindName[11:19],
+}
+
+// KindString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func KindString(s string) (Kind, error) {
+	if val, ok := _KindNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _KindNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Kind values", s)
+}
+
+// KindValues returns all values of the enum
+func KindValues() []Kind {
+	return _KindValues
+}
+
+// KindStrings returns a slice of all String values of the enum
+func KindStrings() []string {
+	strs := make([]string, len(_KindNames))
+	copy(strs, _KindNames)
+	return strs
+}
+
+// IsAKind returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Kind) IsAKind() bool {
+	for _, v := range _KindValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+// Code generated by "enumer -sql -type=State -output=enum_install_state.go"; DO NOT EDIT.
+
+package install
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+const _StateName = "StateNoneStateNeedsInstallStateInstallingStateInstalledStateFailed"
+
+var _StateIndex = [...]uint8{0, 9, 26, 41, 55, 66}
+
+const _StateLowerName = "statenonestateneedsinstallstateinstallingstateinstalledstatefailed"
+
+func (i State) String() string {
+	if i < 0 || i >= State(len(_StateIndex)-1) {
+		return fmt.Sprintf("State(%d)", i)
+	}
+	return _StateName[_StateIndex[i]:_StateIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _StateNoOp() {
+	var x [1]struct{}
+	_ = x[StateNone-(0)]
+	_ = x[StateNeedsInstall-(1)]
+	_ = x[StateInstalling-(2)]
+	_ = x[StateInstalled-(3)]
+	_ = x[StateFailed-(4)]
+}
+
+var _StateValues = []State{StateNone, StateNeedsInstall, StateInstalling, StateInstalled, StateFailed}
+
+var _StateNameToValueMap = map[string]State{
+	_StateName[0:9]:        StateNone,
+	_StateLowerName[0:9]:   StateNone,
+	_StateName[9:26]:       StateNeedsInstall,
+	_StateLowerName[9:26]:  StateNeedsInstall,
+	_StateName[26:41]:      StateInstalling,
+	_StateLowerName[26:41]: StateInstalling,
+	_StateName[41:55]:      StateInstalled,
+	_StateLowerName[41:55]: StateInstalled,
+	_StateName[55:66]:      StateFailed,
+	_StateLowerName[55:66]: StateFailed,
+}
+
+var _StateNames = []string{
+	_StateName[0:9],
+	_StateName[9:26],
+	_StateName[26:41],
+	_StateName[41:55],
+	_StateName[55:66],
+}
+
+// StateString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func StateString(s string) (State, error) {
+	if val, ok := _StateNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _StateNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to State values", s)
+}
+
+// StateValues returns all values of the enum
+func StateValues() []State {
+	return _StateValues
+}
+
+// StateStrings returns a slice of all String values of the enum
+func StateStrings() []string {
+	strs := make([]string, len(_StateNames))
+	copy(strs, _StateNames)
+	return strs
+}
+
+// IsAState returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i State) IsAState() bool {
+	for _, v := range _StateValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (i State) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+func (i *State) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of State: %[1]T(%[1]v)", value)
+	}
+
+	val, err := StateString(str)
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
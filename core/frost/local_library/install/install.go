@@ -0,0 +1,32 @@
+package install
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go run github.com/dmarkham/enumer@latest -sql -type=State -output=enum_install_state.go
+type State int
+
+const (
+	// StateNone is a game that has not finished downloading
+	StateNone State = iota
+	// StateNeedsInstall is a downloaded game with an installer that was not run yet
+	StateNeedsInstall
+	StateInstalling
+	StateInstalled
+	StateFailed
+)
+
+// Info is the install state of a game, embedded in the local game
+type Info struct {
+	InstallState   State
+	InstallPath    string
+	InstallMessage string
+	InstalledAt    time.Time
+}
+
+type StateUpdater interface {
+	// EditInstall updates the install info of the game with the server game id
+	EditInstall(ctx context.Context, gameId int, info *Info) error
+}
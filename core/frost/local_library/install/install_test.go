@@ -0,0 +1,161 @@
+package install
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ra341/glacier/frost/local_library/runner"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for rel, contents := range files {
+		full := filepath.Join(root, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0644))
+	}
+}
+
+func TestDetect(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"game.exe":                    "",
+		"redist/vcredist_install.exe": "",
+		"install.sh":                  "",
+		"Setup.exe":                   "",
+		"Game.AppImage":               "",
+		"data/a/b/c/setup.exe":        "",
+		".frost.cache/setup.exe":      "",
+		"readme.txt":                  "",
+	})
+
+	candidates, err := Detect(root, ".frost.cache")
+	require.NoError(t, err)
+
+	var paths []string
+	for _, c := range candidates {
+		paths = append(paths, c.RelPath)
+	}
+	// exact names first, then by path, too deep and skipped dirs are left out
+	require.Equal(t, []string{
+		"Setup.exe",
+		"install.sh",
+		"Game.AppImage",
+		filepath.Join("redist", "vcredist_install.exe"),
+	}, paths)
+	require.Equal(t, KindShell, candidates[1].Kind)
+	require.Equal(t, KindAppImage, candidates[2].Kind)
+}
+
+type stateRecorder struct {
+	mu     sync.Mutex
+	states []Info
+}
+
+func (s *stateRecorder) EditInstall(_ context.Context, _ int, info *Info) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states = append(s.states, *info)
+	return nil
+}
+
+func (s *stateRecorder) last() Info {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.states) == 0 {
+		return Info{}
+	}
+	return s.states[len(s.states)-1]
+}
+
+func TestRunInstaller(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell installers need sh")
+	}
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"install.sh": "echo installing to $FROST_INSTALL_DIR\n",
+		"broken.sh":  "echo oh no >&2\nexit 3\n",
+	})
+
+	rec := &stateRecorder{}
+	srv := New(t.TempDir(), rec)
+	installDir := filepath.Join(root, "installed")
+
+	require.NoError(t, srv.Run(1, root, Candidate{RelPath: "install.sh", Kind: KindShell}, installDir, runner.Config{}))
+	require.Eventually(t, func() bool {
+		return rec.last().InstallState == StateInstalled
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, installDir, rec.last().InstallPath)
+
+	installLog, err := srv.Log(1)
+	require.NoError(t, err)
+	require.Equal(t, "installing to "+installDir+"\n", installLog)
+
+	require.NoError(t, srv.Run(2, root, Candidate{RelPath: "broken.sh", Kind: KindShell}, installDir, runner.Config{}))
+	require.Eventually(t, func() bool {
+		return rec.last().InstallState == StateFailed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	installLog, err = srv.Log(2)
+	require.NoError(t, err)
+	require.Equal(t, "oh no\n", installLog)
+}
+
+func TestCancelInstaller(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell installers need sh")
+	}
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"install.sh": "exec sleep 30\n",
+	})
+
+	rec := &stateRecorder{}
+	srv := New(t.TempDir(), rec)
+	require.NoError(t, srv.Run(1, root, Candidate{RelPath: "install.sh", Kind: KindShell}, root, runner.Config{}))
+	require.Error(t, srv.Run(1, root, Candidate{RelPath: "install.sh", Kind: KindShell}, root, runner.Config{}))
+
+	require.NoError(t, srv.Cancel(1))
+	require.False(t, srv.Running(1))
+	require.Equal(t, StateFailed, rec.last().InstallState)
+}
+
+func TestRunWindowsInstallerInPrefix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("runners are for windows installers on other systems")
+	}
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"setup.exe": "MZ",
+	})
+	// stands in for wine, prints what it was asked to run
+	wine := filepath.Join(t.TempDir(), "wine")
+	require.NoError(t, os.WriteFile(wine, []byte("#!/bin/sh\necho $WINEPREFIX $*\n"), 0755))
+	prefix := filepath.Join(t.TempDir(), "prefix")
+
+	rec := &stateRecorder{}
+	srv := New(t.TempDir(), rec)
+
+	err := srv.Run(1, root, Candidate{RelPath: "setup.exe", Kind: KindExe}, root, runner.Config{})
+	require.ErrorIs(t, err, ErrUnsupported)
+
+	run := runner.Config{RunnerKind: runner.KindWine, RunnerPath: wine, PrefixPath: prefix}
+	require.NoError(t, srv.Run(1, root, Candidate{RelPath: "setup.exe", Kind: KindExe}, root, run))
+	require.Eventually(t, func() bool {
+		return rec.last().InstallState == StateInstalled
+	}, 5*time.Second, 10*time.Millisecond)
+
+	installLog, err := srv.Log(1)
+	require.NoError(t, err)
+	require.Equal(t, prefix+" "+filepath.Join(root, "setup.exe")+"\n", installLog)
+	require.DirExists(t, prefix)
+}
@@ -0,0 +1,217 @@
+package install
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/ra341/glacier/frost/local_library/runner"
+	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/ra341/glacier/pkg/syncmap"
+
+	"github.com/rs/zerolog/log"
+)
+
+var ErrUnsupported = errors.New("installer cannot run on this system")
+
+// maxLogRead is the tail of the install log returned by Log
+const maxLogRead = 1024 * 1024
+
+type Service struct {
+	logDir   string
+	progress StateUpdater
+
+	// game id -> running installer
+	running syncmap.Map[int, *process]
+}
+
+type process struct {
+	cmd *exec.Cmd
+	// closed once Start returned, cmd.Process is nil before that
+	started chan struct{}
+	done    chan struct{}
+}
+
+func New(logDir string, progress StateUpdater) *Service {
+	return &Service{
+		logDir:   logDir,
+		progress: progress,
+	}
+}
+
+// Run starts the installer in the background and records the result once it exits,
+// installDir is passed to the installer as FROST_INSTALL_DIR and saved as the install location,
+// windows installers run inside the prefix of the runner
+func (s *Service) Run(gameId int, gamePath string, installer Candidate, installDir string, run runner.Config) error {
+	if _, running := s.running.Load(gameId); running {
+		return fmt.Errorf("installer of game %d is already running", gameId)
+	}
+
+	fullPath := filepath.Join(gamePath, installer.RelPath)
+	cmd, err := command(installer.Kind, fullPath, run)
+	if err != nil {
+		return err
+	}
+	cmd.Dir = filepath.Dir(fullPath)
+	cmd.Env = append(cmd.Env, "FROST_INSTALL_DIR="+installDir)
+
+	err = os.MkdirAll(s.logDir, 0755)
+	if err != nil {
+		return fmt.Errorf("could not create log dir: %w", err)
+	}
+	logFile, err := os.Create(s.LogPath(gameId))
+	if err != nil {
+		return fmt.Errorf("could not create install log: %w", err)
+	}
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+
+	proc := &process{cmd: cmd, started: make(chan struct{}), done: make(chan struct{})}
+	if _, running := s.running.LoadOrStore(gameId, proc); running {
+		fileutil.Close(logFile)
+		return fmt.Errorf("installer of game %d is already running", gameId)
+	}
+
+	err = cmd.Start()
+	close(proc.started)
+	if err != nil {
+		s.running.Delete(gameId)
+		fileutil.Close(logFile)
+		return fmt.Errorf("could not start installer: %w", err)
+	}
+
+	log.Info().Int("game", gameId).Str("installer", installer.RelPath).Msg("installer started")
+	s.setState(gameId, &Info{
+		InstallState:   StateInstalling,
+		InstallMessage: "running " + installer.RelPath,
+	})
+
+	go s.wait(gameId, proc, logFile, installDir)
+	return nil
+}
+
+func (s *Service) wait(gameId int, proc *process, logFile io.Closer, installDir string) {
+	defer close(proc.done)
+	defer s.running.Delete(gameId)
+	defer fileutil.Close(logFile)
+
+	err := proc.cmd.Wait()
+	if err != nil {
+		log.Warn().Err(err).Int("game", gameId).Msg("installer failed")
+		s.setState(gameId, &Info{
+			InstallState:   StateFailed,
+			InstallMessage: fmt.Sprintf("installer failed: %v, see the install log", err),
+		})
+		return
+	}
+
+	log.Info().Int("game", gameId).Msg("installer finished")
+	s.setState(gameId, &Info{
+		InstallState:   StateInstalled,
+		InstallPath:    installDir,
+		InstallMessage: "Installed",
+		InstalledAt:    time.Now(),
+	})
+}
+
+// Cancel kills the running installer and waits for it to exit
+func (s *Service) Cancel(gameId int) error {
+	proc, ok := s.running.Load(gameId)
+	if !ok {
+		return fmt.Errorf("no installer running for game %d", gameId)
+	}
+
+	<-proc.started
+	if proc.cmd.Process == nil {
+		return fmt.Errorf("installer of game %d could not be started", gameId)
+	}
+
+	err := proc.cmd.Process.Kill()
+	if err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	<-proc.done
+	return nil
+}
+
+func (s *Service) Running(gameId int) bool {
+	_, ok := s.running.Load(gameId)
+	return ok
+}
+
+func (s *Service) LogPath(gameId int) string {
+	return filepath.Join(s.logDir, fmt.Sprintf("install-%d.log", gameId))
+}
+
+// Log returns the end of the output of the last installer run for the game
+func (s *Service) Log(gameId int) (string, error) {
+	file, err := os.Open(s.LogPath(gameId))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer fileutil.Close(file)
+
+	stat, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	if stat.Size() > maxLogRead {
+		_, err = file.Seek(-maxLogRead, io.SeekEnd)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	contents, err := io.ReadAll(file)
+	return string(contents), err
+}
+
+func (s *Service) setState(gameId int, info *Info) {
+	err := s.progress.EditInstall(context.Background(), gameId, info)
+	if err != nil {
+		log.Warn().Err(err).Int("game", gameId).Msg("could not save install state")
+	}
+}
+
+// command builds the process for the installer on this OS,
+// the env of the process is set
+func command(kind Kind, fullPath string, run runner.Config) (*exec.Cmd, error) {
+	var (
+		spec runner.Spec
+		err  error
+	)
+	switch kind {
+	case KindShell:
+		spec = runner.Spec{Path: "sh", Args: []string{fullPath}}
+	case KindAppImage:
+		err = os.Chmod(fullPath, 0755)
+		if err != nil {
+			return nil, fmt.Errorf("could not make AppImage executable: %w", err)
+		}
+		spec = runner.Spec{Path: fullPath}
+	case KindExe:
+		spec, err = run.Wrap(fullPath)
+	case KindMsi:
+		spec, err = run.Wrap("msiexec", "/i", fullPath)
+	default:
+		return nil, fmt.Errorf("%w: unknown installer kind %s", ErrUnsupported, kind)
+	}
+	if errors.Is(err, runner.ErrNoRunner) {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupported, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(spec.Path, spec.Args...)
+	cmd.Env = append(os.Environ(), spec.Env...)
+	return cmd, nil
+}
@@ -0,0 +1,44 @@
+package play
+
+import (
+	"context"
+	"time"
+
+	"github.com/ra341/glacier/frost/local_library/runner"
+
+	"gorm.io/gorm"
+)
+
+// Session is a single run of a game
+type Session struct {
+	gorm.Model
+
+	GameId  int `gorm:"index"`
+	Started time.Time
+	Ended   time.Time
+	// Seconds the game was running
+	Seconds  int64
+	ExitCode int
+}
+
+func (Session) TableName() string {
+	return "play_sessions"
+}
+
+// Options is how a game is started
+type Options struct {
+	// absolute path of the executable
+	Exe  string
+	Args []string
+	// KEY=VALUE pairs added to the frost environment
+	Env []string
+	// defaults to the folder of Exe
+	WorkDir string
+	// runs windows games on other systems, resolved by the caller
+	Runner runner.Config
+}
+
+type SessionRecorder interface {
+	// RecordSession is called once the game exits
+	RecordSession(ctx context.Context, session *Session) error
+}
@@ -0,0 +1,88 @@
+package play
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sessionRecorder struct {
+	mu       sync.Mutex
+	sessions []Session
+}
+
+func (s *sessionRecorder) RecordSession(_ context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = append(s.sessions, *session)
+	return nil
+}
+
+func (s *sessionRecorder) recorded() []Session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.sessions)
+}
+
+func writeScript(t *testing.T, contents string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("test games are shell scripts")
+	}
+
+	exe := filepath.Join(t.TempDir(), "game.sh")
+	require.NoError(t, os.WriteFile(exe, []byte("#!/bin/sh\n"+contents), 0755))
+	return exe
+}
+
+func TestLaunchRecordsSession(t *testing.T) {
+	exe := writeScript(t, `[ "$1" = "--fast" ] && [ "$MODE" = "test" ] && [ "$(pwd)" = "$2" ] && exit 3`+"\nexit 1\n")
+	workDir := t.TempDir()
+
+	rec := &sessionRecorder{}
+	srv := New(rec)
+	require.NoError(t, srv.Launch(7, Options{
+		Exe:     exe,
+		Args:    []string{"--fast", workDir},
+		Env:     []string{"MODE=test"},
+		WorkDir: workDir,
+	}))
+
+	require.Eventually(t, func() bool {
+		return len(rec.recorded()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	session := rec.recorded()[0]
+	require.Equal(t, 7, session.GameId)
+	// args, env and the working dir reached the game
+	require.Equal(t, 3, session.ExitCode)
+	require.False(t, session.Ended.Before(session.Started))
+	require.Empty(t, srv.Running())
+}
+
+func TestStopGame(t *testing.T) {
+	exe := writeScript(t, "exec sleep 30\n")
+
+	rec := &sessionRecorder{}
+	srv := New(rec)
+	require.NoError(t, srv.Launch(1, Options{Exe: exe}))
+	require.Error(t, srv.Launch(1, Options{Exe: exe}), "a game runs once at a time")
+	require.Equal(t, []int{1}, srv.Running())
+
+	require.NoError(t, srv.Stop(1))
+	require.Len(t, rec.recorded(), 1)
+	require.Empty(t, srv.Running())
+	require.Error(t, srv.Stop(1))
+}
+
+func TestLaunchNeedsAbsoluteExe(t *testing.T) {
+	srv := New(&sessionRecorder{})
+	require.Error(t, srv.Launch(1, Options{Exe: "game.exe"}))
+	require.Error(t, srv.Launch(1, Options{Exe: filepath.Join(t.TempDir(), "missing")}))
+}
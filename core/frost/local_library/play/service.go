@@ -0,0 +1,139 @@
+package play
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/ra341/glacier/pkg/syncmap"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	recorder SessionRecorder
+
+	// game id -> running game
+	running syncmap.Map[int, *process]
+}
+
+type process struct {
+	cmd     *exec.Cmd
+	started time.Time
+	// closed once Start returned, cmd.Process is nil before that
+	launched chan struct{}
+	done     chan struct{}
+}
+
+func New(recorder SessionRecorder) *Service {
+	return &Service{
+		recorder: recorder,
+	}
+}
+
+// Launch starts the game and records a session once it exits,
+// a game can only run once at a time
+func (s *Service) Launch(gameId int, opts Options) error {
+	if _, running := s.running.Load(gameId); running {
+		return fmt.Errorf("game %d is already running", gameId)
+	}
+
+	if !filepath.IsAbs(opts.Exe) {
+		return fmt.Errorf("executable path must be absolute: %s", opts.Exe)
+	}
+	if _, err := os.Stat(opts.Exe); err != nil {
+		return fmt.Errorf("could not find executable: %w", err)
+	}
+
+	spec, err := opts.Runner.Wrap(opts.Exe, opts.Args...)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(spec.Path, spec.Args...)
+	cmd.Dir = opts.WorkDir
+	if cmd.Dir == "" {
+		cmd.Dir = filepath.Dir(opts.Exe)
+	}
+	// the game env goes last so it can override the runner
+	cmd.Env = append(append(os.Environ(), spec.Env...), opts.Env...)
+
+	proc := &process{cmd: cmd, launched: make(chan struct{}), done: make(chan struct{})}
+	if _, running := s.running.LoadOrStore(gameId, proc); running {
+		return fmt.Errorf("game %d is already running", gameId)
+	}
+
+	err = cmd.Start()
+	proc.started = time.Now()
+	close(proc.launched)
+	if err != nil {
+		s.running.Delete(gameId)
+		return fmt.Errorf("could not start game: %w", err)
+	}
+
+	log.Info().Int("game", gameId).Str("exe", opts.Exe).Msg("game launched")
+	go s.wait(gameId, proc)
+	return nil
+}
+
+func (s *Service) wait(gameId int, proc *process) {
+	defer close(proc.done)
+	defer s.running.Delete(gameId)
+
+	err := proc.cmd.Wait()
+	ended := time.Now()
+
+	exitCode := 0
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	} else if err != nil {
+		log.Warn().Err(err).Int("game", gameId).Msg("could not wait for game")
+	}
+
+	session := &Session{
+		GameId:   gameId,
+		Started:  proc.started,
+		Ended:    ended,
+		Seconds:  int64(ended.Sub(proc.started).Seconds()),
+		ExitCode: exitCode,
+	}
+	log.Info().Int("game", gameId).
+		Int64("seconds", session.Seconds).
+		Int("exit", exitCode).
+		Msg("game exited")
+
+	err = s.recorder.RecordSession(context.Background(), session)
+	if err != nil {
+		log.Warn().Err(err).Int("game", gameId).Msg("could not record play session")
+	}
+}
+
+// Stop kills the game and waits until its session is recorded
+func (s *Service) Stop(gameId int) error {
+	proc, ok := s.running.Load(gameId)
+	if !ok {
+		return fmt.Errorf("game %d is not running", gameId)
+	}
+
+	<-proc.launched
+	if proc.cmd.Process == nil {
+		return fmt.Errorf("game %d could not be started", gameId)
+	}
+
+	err := proc.cmd.Process.Kill()
+	if err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	<-proc.done
+	return nil
+}
+
+// Running lists the ids of the games that are running
+func (s *Service) Running() []int {
+	return s.running.Keys()
+}
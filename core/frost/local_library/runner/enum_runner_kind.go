@@ -0,0 +1,113 @@
+// Code generated by "enumer -sql -type=Kind -trimprefix=Kind -output=enum_runner_kind.go"; DO NOT EDIT.
+
+package runner
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+const _KindName = "NativeWineProton"
+
+var _KindIndex = [...]uint8{0, 6, 10, 16}
+
+const _KindLowerName = "nativewineproton"
+
+func (i Kind) String() string {
+	if i < 0 || i >= Kind(len(_KindIndex)-1) {
+		return fmt.Sprintf("Kind(%d)", i)
+	}
+	return _KindName[_KindIndex[i]:_KindIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _KindNoOp() {
+	var x [1]struct{}
+	_ = x[KindNative-(0)]
+	_ = x[KindWine-(1)]
+	_ = x[KindProton-(2)]
+}
+
+var _KindValues = []Kind{KindNative, KindWine, KindProton}
+
+var _KindNameToValueMap = map[string]Kind{
+	_KindName[0:6]:        KindNative,
+	_KindLowerName[0:6]:   KindNative,
+	_KindName[6:10]:       KindWine,
+	_KindLowerName[6:10]:  KindWine,
+	_KindName[10:16]:      KindProton,
+	_KindLowerName[10:16]: KindProton,
+}
+
+var _KindNames = []string{
+	_KindName[0:6],
+	_KindName[6:10],
+	_KindName[10:16],
+}
+
+// KindString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func KindString(s string) (Kind, error) {
+	if val, ok := _KindNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _KindNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Kind values", s)
+}
+
+// KindValues returns all values of the enum
+func KindValues() []Kind {
+	return _KindValues
+}
+
+// KindStrings returns a slice of all String values of the enum
+func KindStrings() []string {
+	strs := make([]string, len(_KindNames))
+	copy(strs, _KindNames)
+	return strs
+}
+
+// IsAKind returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Kind) IsAKind() bool {
+	for _, v := range _KindValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (i Kind) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+func (i *Kind) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of Kind: %[1]T(%[1]v)", value)
+	}
+
+	val, err := KindString(str)
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
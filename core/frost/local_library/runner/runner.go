@@ -0,0 +1,121 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+//go:generate go run github.com/dmarkham/enumer@latest -sql -type=Kind -trimprefix=Kind -output=enum_runner_kind.go
+type Kind int
+
+const (
+	// KindNative runs the executable as is
+	KindNative Kind = iota
+	KindWine
+	KindProton
+)
+
+var ErrNoRunner = errors.New("windows executables need a wine or proton runner on this system")
+
+// Runner is a wine or proton install found on the system
+type Runner struct {
+	Kind Kind
+	Name string
+	// wine binary or proton script
+	Path    string
+	Version string
+}
+
+// Config is the runner of a game, embedded in the local game
+type Config struct {
+	RunnerKind Kind
+	// wine binary or proton script, empty uses the first one found
+	RunnerPath string
+	// empty uses the default prefix of the game
+	PrefixPath string
+}
+
+// Spec is the command line that starts a program through a runner
+type Spec struct {
+	Path string
+	Args []string
+	// KEY=VALUE pairs added to the frost environment
+	Env []string
+}
+
+// Wrap builds the command line that runs exe with args through the runner,
+// the prefix is created if it does not exist
+func (c Config) Wrap(exe string, args ...string) (Spec, error) {
+	switch c.RunnerKind {
+	case KindNative:
+		if runtime.GOOS != "windows" && isWindowsExe(exe) {
+			return Spec{}, fmt.Errorf("%w: %s", ErrNoRunner, filepath.Base(exe))
+		}
+		return Spec{Path: exe, Args: args}, nil
+	case KindWine:
+		err := c.makePrefix()
+		if err != nil {
+			return Spec{}, err
+		}
+		return Spec{
+			Path: c.RunnerPath,
+			Args: append([]string{exe}, args...),
+			Env:  []string{"WINEPREFIX=" + c.PrefixPath},
+		}, nil
+	case KindProton:
+		err := c.makePrefix()
+		if err != nil {
+			return Spec{}, err
+		}
+		return Spec{
+			Path: c.RunnerPath,
+			Args: append([]string{"run", exe}, args...),
+			Env: []string{
+				// proton keeps its wine prefix in pfx under this folder
+				"STEAM_COMPAT_DATA_PATH=" + c.PrefixPath,
+				// proton refuses to start without it, steam does not have to be installed
+				"STEAM_COMPAT_CLIENT_INSTALL_PATH=" + steamRoot(c.RunnerPath),
+			},
+		}, nil
+	default:
+		return Spec{}, fmt.Errorf("unknown runner %s", c.RunnerKind)
+	}
+}
+
+func (c Config) makePrefix() error {
+	if c.RunnerPath == "" || c.PrefixPath == "" {
+		return fmt.Errorf("%s runner needs a runner path and a prefix", c.RunnerKind)
+	}
+	err := os.MkdirAll(c.PrefixPath, 0755)
+	if err != nil {
+		return fmt.Errorf("could not create prefix: %w", err)
+	}
+	return nil
+}
+
+// isWindowsExe includes msiexec, which only exists inside a prefix
+func isWindowsExe(exe string) bool {
+	switch strings.ToLower(filepath.Ext(exe)) {
+	case ".exe", ".msi", ".bat":
+		return true
+	}
+	return exe == "msiexec"
+}
+
+// steamRoot guesses the steam folder from the proton script,
+// <steam>/steamapps/common/<proton>/proton or <steam>/compatibilitytools.d/<proton>/proton
+func steamRoot(protonScript string) string {
+	protonDir := filepath.Dir(protonScript)
+	parent := filepath.Dir(protonDir)
+	switch filepath.Base(parent) {
+	case "common":
+		return filepath.Dir(filepath.Dir(parent))
+	case "compatibilitytools.d":
+		return filepath.Dir(parent)
+	}
+	return protonDir
+}
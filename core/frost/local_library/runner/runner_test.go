@@ -0,0 +1,96 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeExe(t *testing.T, path, contents string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0755))
+}
+
+func TestWrap(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("runners are for windows games on other systems")
+	}
+
+	spec, err := Config{}.Wrap("/games/a/start.sh", "-w")
+	require.NoError(t, err)
+	require.Equal(t, Spec{Path: "/games/a/start.sh", Args: []string{"-w"}}, spec)
+
+	_, err = Config{}.Wrap("/games/a/Game.EXE")
+	require.ErrorIs(t, err, ErrNoRunner)
+
+	prefix := filepath.Join(t.TempDir(), "prefix")
+	spec, err = Config{RunnerKind: KindWine, RunnerPath: "/usr/bin/wine", PrefixPath: prefix}.Wrap("/games/a/game.exe", "-w")
+	require.NoError(t, err)
+	require.Equal(t, Spec{
+		Path: "/usr/bin/wine",
+		Args: []string{"/games/a/game.exe", "-w"},
+		Env:  []string{"WINEPREFIX=" + prefix},
+	}, spec)
+	require.DirExists(t, prefix)
+
+	proton := "/home/a/.steam/root/steamapps/common/Proton 9.0/proton"
+	spec, err = Config{RunnerKind: KindProton, RunnerPath: proton, PrefixPath: prefix}.Wrap("/games/a/game.exe")
+	require.NoError(t, err)
+	require.Equal(t, Spec{
+		Path: proton,
+		Args: []string{"run", "/games/a/game.exe"},
+		Env: []string{
+			"STEAM_COMPAT_DATA_PATH=" + prefix,
+			"STEAM_COMPAT_CLIENT_INSTALL_PATH=/home/a/.steam/root",
+		},
+	}, spec)
+
+	_, err = Config{RunnerKind: KindWine}.Wrap("/games/a/game.exe")
+	require.Error(t, err)
+}
+
+func TestDiscoverAndResolve(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("runners are for windows games on other systems")
+	}
+	// keep wine installed on the machine out of the results
+	t.Setenv("PATH", t.TempDir())
+
+	home := t.TempDir()
+	lutrisWine := filepath.Join(home, ".local/share/lutris/runners/wine/wine-ge-8-26/bin/wine")
+	writeExe(t, lutrisWine, "")
+	oldProton := filepath.Join(home, ".steam/root/steamapps/common/Proton 8.0/proton")
+	writeExe(t, oldProton, "")
+	writeExe(t, filepath.Join(filepath.Dir(oldProton), "version"), "1700000000 proton-8.0-5\n")
+	newProton := filepath.Join(home, ".steam/root/compatibilitytools.d/GE-Proton9-20/proton")
+	writeExe(t, newProton, "")
+	// a folder without the script is not a runner
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".steam/root/compatibilitytools.d/broken"), 0755))
+
+	srv := &Service{prefixDir: filepath.Join(home, "prefixes"), home: home}
+	require.Equal(t, []Runner{
+		{Kind: KindWine, Name: "wine-ge-8-26", Path: lutrisWine, Version: "wine-ge-8-26"},
+		{Kind: KindProton, Name: "Proton 8.0", Path: oldProton, Version: "proton-8.0-5"},
+		{Kind: KindProton, Name: "GE-Proton9-20", Path: newProton, Version: "GE-Proton9-20"},
+	}, srv.Discover())
+
+	conf, err := srv.Resolve(4, Config{RunnerKind: KindWine})
+	require.NoError(t, err)
+	require.Equal(t, Config{RunnerKind: KindWine, RunnerPath: lutrisWine, PrefixPath: filepath.Join(home, "prefixes", "4")}, conf)
+
+	conf, err = srv.Resolve(4, Config{RunnerKind: KindProton, RunnerPath: newProton, PrefixPath: "/pfx"})
+	require.NoError(t, err)
+	require.Equal(t, Config{RunnerKind: KindProton, RunnerPath: newProton, PrefixPath: "/pfx"}, conf)
+
+	conf, err = srv.Resolve(4, Config{RunnerPath: newProton})
+	require.NoError(t, err)
+	require.Equal(t, Config{}, conf, "native ignores the paths")
+
+	_, err = srv.Resolve(4, Config{RunnerKind: KindProton, RunnerPath: "proton"})
+	require.Error(t, err)
+	_, err = srv.Resolve(4, Config{RunnerKind: KindProton, RunnerPath: filepath.Join(home, "missing")})
+	require.Error(t, err)
+}
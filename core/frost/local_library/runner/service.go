@@ -0,0 +1,182 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	prefixDir string
+	// home folder searched for user installs
+	home string
+}
+
+// New keeps the prefix of each game under prefixDir
+func New(prefixDir string) *Service {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		log.Warn().Err(err).Msg("could not find home dir, only system runners will be found")
+	}
+
+	return &Service{
+		prefixDir: prefixDir,
+		home:      home,
+	}
+}
+
+// Prefix is the default prefix folder of the game
+func (s *Service) Prefix(gameId int) string {
+	return filepath.Join(s.prefixDir, strconv.Itoa(gameId))
+}
+
+// Resolve fills the defaults of a game runner config and checks the runner exists
+func (s *Service) Resolve(gameId int, conf Config) (Config, error) {
+	if conf.RunnerKind == KindNative {
+		return Config{RunnerKind: KindNative}, nil
+	}
+
+	if conf.PrefixPath == "" {
+		conf.PrefixPath = s.Prefix(gameId)
+	}
+
+	if conf.RunnerPath == "" {
+		runners := s.Discover()
+		idx := slices.IndexFunc(runners, func(r Runner) bool {
+			return r.Kind == conf.RunnerKind
+		})
+		if idx < 0 {
+			return conf, fmt.Errorf("no %s runner found, set the runner path", conf.RunnerKind)
+		}
+		conf.RunnerPath = runners[idx].Path
+		return conf, nil
+	}
+
+	if !filepath.IsAbs(conf.RunnerPath) {
+		return conf, fmt.Errorf("runner path must be absolute: %s", conf.RunnerPath)
+	}
+	if _, err := os.Stat(conf.RunnerPath); err != nil {
+		return conf, fmt.Errorf("could not find runner: %w", err)
+	}
+	return conf, nil
+}
+
+// Discover scans the usual wine and proton install locations,
+// wine from PATH comes first, proton is sorted by its version string
+func (s *Service) Discover() []Runner {
+	var runners []Runner
+	seen := map[string]bool{}
+	add := func(r Runner) {
+		real, err := filepath.EvalSymlinks(r.Path)
+		if err != nil || seen[real] {
+			return
+		}
+		seen[real] = true
+		runners = append(runners, r)
+	}
+
+	for _, name := range []string{"wine", "wine64"} {
+		path, err := exec.LookPath(name)
+		if err == nil {
+			add(Runner{Kind: KindWine, Name: name, Path: path, Version: "system"})
+		}
+	}
+	for _, pattern := range s.winePatterns() {
+		for _, path := range glob(pattern) {
+			// <runner>/bin/wine
+			dir := filepath.Dir(filepath.Dir(path))
+			add(Runner{Kind: KindWine, Name: filepath.Base(dir), Path: path, Version: filepath.Base(dir)})
+		}
+	}
+
+	var protons []Runner
+	for _, pattern := range s.protonPatterns() {
+		for _, path := range glob(pattern) {
+			protons = append(protons, protonRunner(path))
+		}
+	}
+	slices.SortStableFunc(protons, func(a, b Runner) int {
+		return strings.Compare(b.Version, a.Version)
+	})
+	for _, r := range protons {
+		add(r)
+	}
+
+	return runners
+}
+
+func (s *Service) winePatterns() []string {
+	patterns := []string{
+		"/opt/wine*/bin/wine",
+		"/usr/lib/wine/wine",
+	}
+	if s.home != "" {
+		patterns = append(patterns,
+			filepath.Join(s.home, ".local/share/lutris/runners/wine/*/bin/wine"),
+			filepath.Join(s.home, ".var/app/net.lutris.Lutris/data/lutris/runners/wine/*/bin/wine"),
+		)
+	}
+	return patterns
+}
+
+func (s *Service) protonPatterns() []string {
+	patterns := []string{
+		"/usr/share/steam/compatibilitytools.d/*/proton",
+		"/usr/local/share/steam/compatibilitytools.d/*/proton",
+	}
+	if s.home != "" {
+		for _, steam := range []string{
+			".steam/root",
+			".steam/steam",
+			".local/share/Steam",
+			".var/app/com.valvesoftware.Steam/data/Steam",
+		} {
+			patterns = append(patterns,
+				filepath.Join(s.home, steam, "steamapps/common/Proton*/proton"),
+				filepath.Join(s.home, steam, "compatibilitytools.d/*/proton"),
+			)
+		}
+	}
+	return patterns
+}
+
+// protonRunner reads the version file next to the proton script,
+// it holds "<build timestamp> <version>"
+func protonRunner(path string) Runner {
+	dir := filepath.Dir(path)
+	r := Runner{Kind: KindProton, Name: filepath.Base(dir), Path: path, Version: filepath.Base(dir)}
+
+	contents, err := os.ReadFile(filepath.Join(dir, "version"))
+	if err != nil {
+		return r
+	}
+	fields := strings.Fields(string(contents))
+	if len(fields) == 2 {
+		r.Version = fields[1]
+	}
+	return r
+}
+
+// glob only keeps files, broken installs are skipped
+func glob(pattern string) []string {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil
+	}
+
+	var files []string
+	for _, match := range matches {
+		stat, err := os.Stat(match)
+		if err != nil || stat.IsDir() {
+			continue
+		}
+		files = append(files, match)
+	}
+	return files
+}
@@ -4,36 +4,75 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	hc "github.com/ra341/glacier/frost/http_client"
 	"github.com/ra341/glacier/frost/local_library/download"
+	"github.com/ra341/glacier/frost/local_library/install"
+	"github.com/ra341/glacier/frost/local_library/play"
+	"github.com/ra341/glacier/frost/local_library/runner"
 	librpc "github.com/ra341/glacier/generated/library/v1"
 	glacier "github.com/ra341/glacier/generated/library/v1/v1connect"
+	indexer "github.com/ra341/glacier/internal/indexer/types"
 	"github.com/ra341/glacier/internal/library"
+	"github.com/ra341/glacier/pkg/syncmap"
+
+	"github.com/rs/zerolog/log"
 )
 
 type Service struct {
 	store      Store
 	baseurl    string
 	downloader *download.Service
+	installer  *install.Service
+	player     *play.Service
+	runners    *runner.Service
 	lib        glacier.LibraryServiceClient
+
+	// report play sessions to glacier
+	syncPlaytime bool
+	// games re-downloading bad files, their install state is kept
+	repairing syncmap.Map[int, struct{}]
 }
 
-func New(baseurl string, store Store, downloader *download.Service, cli hc.HttpCliFactory) *Service {
+func New(
+	baseurl string,
+	store Store,
+	downloader *download.Service,
+	installer *install.Service,
+	runners *runner.Service,
+	cli hc.HttpCliFactory,
+	syncPlaytime bool,
+) *Service {
 	s := &Service{
-		lib:        glacier.NewLibraryServiceClient(cli(&http.Transport{}), baseurl),
-		store:      store,
-		baseurl:    baseurl,
-		downloader: downloader,
+		lib:          glacier.NewLibraryServiceClient(cli(&http.Transport{}), baseurl),
+		store:        store,
+		baseurl:      baseurl,
+		downloader:   downloader,
+		installer:    installer,
+		runners:      runners,
+		syncPlaytime: syncPlaytime,
 	}
+	s.player = play.New(s)
+	downloader.OnFinished(s.downloadFinished)
+
+	go func() {
+		err := s.resumeIncomplete(context.Background())
+		if err != nil {
+			log.Warn().Err(err).Msg("could not resume downloads")
+		}
+	}()
+
 	return s
 }
 
-func (s *Service) Download(ctx context.Context, gameId int, downloadFolder string) error {
-	var ll LocalGame
-
+// Download queues the game to be downloaded into the folder,
+// a game that was downloaded before is updated in place
+func (s *Service) Download(ctx context.Context, gameId int, downloadFolder string, priority int) error {
 	request := connect.NewRequest(&librpc.GetGameRequest{GameId: uint64(gameId)})
 	game, err := s.lib.GetGame(ctx, request)
 	if err != nil {
@@ -43,23 +82,410 @@ func (s *Service) Download(ctx context.Context, gameId int, downloadFolder strin
 	var libGame library.Game
 	libGame.FromProto(game.Msg.Game)
 
+	ll, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return fmt.Errorf("could not check for existing game: %w", err)
+	}
+
 	ll.GameId = gameId
 	ll.Game = libGame
-	ll.Download.Started = time.Now()
+	ll.Priority = priority
+	ll.Download = download.Info{
+		DownloadPath: download.GamePath(downloadFolder, gameId),
+		Started:      time.Now(),
+	}
 
-	err = s.store.Add(ctx, &ll)
+	if found {
+		err = s.store.Edit(ctx, int(ll.ID), &ll)
+	} else {
+		err = s.store.Add(ctx, &ll)
+	}
 	if err != nil {
 		return fmt.Errorf("could not add game to DB: %w", err)
 	}
 
-	return s.downloader.Download(gameId, downloadFolder)
+	return s.downloader.Download(gameId, downloadFolder, priority)
+}
+
+// Pause stops the download, it continues from the downloaded chunks on Resume
+func (s *Service) Pause(ctx context.Context, gameId int) error {
+	err := s.downloader.Pause(gameId)
+	if err != nil {
+		return err
+	}
+
+	return s.store.EditStatus(ctx, gameId, &download.Info{
+		Status:        download.StatusPaused,
+		StatusMessage: "Paused",
+	})
+}
+
+func (s *Service) Resume(ctx context.Context, gameId int) error {
+	ll, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	if !found || ll.Download.DownloadPath == "" {
+		return fmt.Errorf("game %d was never downloaded", gameId)
+	}
+
+	return s.downloader.Resume(gameId, ll.Download.DownloadPath, ll.Priority)
+}
+
+// Cancel stops the download and deletes the downloaded files and the game
+func (s *Service) Cancel(ctx context.Context, gameId int) error {
+	ll, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("game %d not found", gameId)
+	}
+
+	err = s.downloader.Cancel(gameId, ll.Download.DownloadPath)
+	if err != nil {
+		return fmt.Errorf("could not remove downloaded files: %w", err)
+	}
+
+	return s.store.Delete(ctx, int(ll.ID))
+}
+
+// SetPriority changes the priority of the game, reordering the queue if it is waiting
+func (s *Service) SetPriority(ctx context.Context, gameId int, priority int) error {
+	err := s.store.EditPriority(ctx, gameId, priority)
+	if err != nil {
+		return err
+	}
+
+	s.downloader.SetPriority(gameId, priority)
+	return nil
+}
+
+func (s *Service) ListQueue() []download.QueueEntry {
+	return s.downloader.Queue()
+}
+
+// WatchProgress streams download progress until the returned func is called
+func (s *Service) WatchProgress() (<-chan download.ProgressEvent, func()) {
+	return s.downloader.Watch()
+}
+
+func (s *Service) GetBandwidth() (bandwidth download.Bandwidth, current int64) {
+	throttle := s.downloader.Throttle()
+	return throttle.Get(), throttle.Current()
+}
+
+// SetBandwidth changes the speed limits of running and future downloads
+func (s *Service) SetBandwidth(bandwidth download.Bandwidth) {
+	s.downloader.Throttle().Set(bandwidth)
+}
+
+// downloadFinished checks completed downloads for installers,
+// repaired games keep their install state
+func (s *Service) downloadFinished(gameId int, status download.Status) {
+	_, repaired := s.repairing.LoadAndDelete(gameId)
+	if repaired || status != download.StatusComplete {
+		return
+	}
+
+	err := s.checkInstall(context.Background(), gameId)
+	if err != nil {
+		log.Warn().Err(err).Int("game", gameId).Msg("could not check for installers")
+	}
+}
+
+// checkInstall marks a downloaded game as installed when it has nothing to install
+func (s *Service) checkInstall(ctx context.Context, gameId int) error {
+	ll, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil || !found {
+		return err
+	}
+
+	candidates, err := s.ListInstallers(ctx, gameId)
+	if err != nil {
+		return err
+	}
+
+	gameType := ll.Game.Source.GameType
+	if gameType == indexer.Standalone || (gameType != indexer.Installer && len(candidates) == 0) {
+		return s.store.EditInstall(ctx, gameId, &install.Info{
+			InstallState:   install.StateInstalled,
+			InstallPath:    ll.Download.DownloadPath,
+			InstallMessage: "Ready to play",
+			InstalledAt:    time.Now(),
+		})
+	}
+
+	return s.store.EditInstall(ctx, gameId, &install.Info{
+		InstallState:   install.StateNeedsInstall,
+		InstallMessage: fmt.Sprintf("found %d installers", len(candidates)),
+	})
+}
+
+// ListInstallers finds installer candidates in the downloaded files
+func (s *Service) ListInstallers(ctx context.Context, gameId int) ([]install.Candidate, error) {
+	ll, err := s.downloaded(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	return install.Detect(ll.Download.DownloadPath, download.MetadataFolder)
+}
+
+// Install runs the installer picked from ListInstallers,
+// installDir defaults to the download path
+func (s *Service) Install(ctx context.Context, gameId int, installerPath, installDir string) error {
+	ll, err := s.downloaded(ctx, gameId)
+	if err != nil {
+		return err
+	}
+
+	candidates, err := install.Detect(ll.Download.DownloadPath, download.MetadataFolder)
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(candidates, func(c install.Candidate) bool {
+		return c.RelPath == filepath.Clean(installerPath)
+	})
+	if idx < 0 {
+		return fmt.Errorf("%s is not an installer of game %d", installerPath, gameId)
+	}
+
+	if installDir == "" {
+		installDir = ll.Download.DownloadPath
+	}
+
+	run, err := s.runners.Resolve(gameId, ll.Runner)
+	if err != nil {
+		return err
+	}
+
+	ll.Play.InstallerPath = candidates[idx].RelPath
+	err = s.store.Edit(ctx, int(ll.ID), &ll)
+	if err != nil {
+		return err
+	}
+
+	return s.installer.Run(gameId, ll.Download.DownloadPath, candidates[idx], installDir, run)
+}
+
+func (s *Service) CancelInstall(ctx context.Context, gameId int) error {
+	err := s.installer.Cancel(gameId)
+	if err != nil {
+		return err
+	}
+	// the failed state saved when the installer exits is replaced
+	return s.store.EditInstall(ctx, gameId, &install.Info{
+		InstallState:   install.StateNeedsInstall,
+		InstallMessage: "Install cancelled",
+	})
+}
+
+func (s *Service) InstallLog(gameId int) (string, error) {
+	return s.installer.Log(gameId)
+}
+
+// Verify compares the downloaded files with the server manifest
+func (s *Service) Verify(ctx context.Context, gameId int) (*download.VerifyReport, error) {
+	ll, err := s.downloaded(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.downloader.Verify(ctx, gameId, ll.Download.DownloadPath)
+}
+
+// Repair verifies the game and downloads its missing and modified files again
+func (s *Service) Repair(ctx context.Context, gameId int) (*download.VerifyReport, error) {
+	ll, err := s.downloaded(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.downloader.Verify(ctx, gameId, ll.Download.DownloadPath)
+	if err != nil || report.Ok() {
+		return report, err
+	}
+
+	s.repairing.Store(gameId, struct{}{})
+	err = s.downloader.Repair(gameId, ll.Download.DownloadPath, report, ll.Priority)
+	if err != nil {
+		s.repairing.Delete(gameId)
+		return nil, err
+	}
+	return report, nil
+}
+
+// SetLaunchOptions sets how Launch starts the game
+func (s *Service) SetLaunchOptions(ctx context.Context, gameId int, opts *GamePlay) error {
+	_, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("game %d not found", gameId)
+	}
+
+	for _, env := range opts.Env {
+		if !strings.Contains(env, "=") {
+			return fmt.Errorf("invalid env %q, expected KEY=VALUE", env)
+		}
+	}
+
+	return s.store.EditPlay(ctx, gameId, opts)
+}
+
+// Launch starts the exe of the game, the session is recorded when it exits
+func (s *Service) Launch(ctx context.Context, gameId int) error {
+	ll, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("game %d not found", gameId)
+	}
+	if ll.Play.ExePath == "" {
+		return fmt.Errorf("no executable set for game %d", gameId)
+	}
+
+	installPath := ll.Install.InstallPath
+	if installPath == "" {
+		installPath = ll.Download.DownloadPath
+	}
+
+	run, err := s.runners.Resolve(gameId, ll.Runner)
+	if err != nil {
+		return err
+	}
+
+	opts := play.Options{
+		Exe:    resolvePath(installPath, ll.Play.ExePath),
+		Args:   ll.Play.Args,
+		Env:    ll.Play.Env,
+		Runner: run,
+	}
+	if ll.Play.WorkDir != "" {
+		opts.WorkDir = resolvePath(installPath, ll.Play.WorkDir)
+	}
+
+	return s.player.Launch(gameId, opts)
+}
+
+func (s *Service) ListRunners() []runner.Runner {
+	return s.runners.Discover()
+}
+
+// SetRunner checks the runner exists and saves it with the defaults filled in,
+// the prefix is created on the first install or launch
+func (s *Service) SetRunner(ctx context.Context, gameId int, conf runner.Config) error {
+	_, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("game %d not found", gameId)
+	}
+
+	conf, err = s.runners.Resolve(gameId, conf)
+	if err != nil {
+		return err
+	}
+	return s.store.EditRunner(ctx, gameId, &conf)
+}
+
+func (s *Service) StopGame(gameId int) error {
+	return s.player.Stop(gameId)
+}
+
+func (s *Service) ListRunning() []int {
+	return s.player.Running()
+}
+
+func (s *Service) ListSessions(ctx context.Context, gameId int, limit int) ([]play.Session, int64, error) {
+	ll, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !found {
+		return nil, 0, fmt.Errorf("game %d not found", gameId)
+	}
+
+	sessions, err := s.store.ListSessions(ctx, gameId, limit)
+	return sessions, ll.Play.PlaytimeSeconds, err
+}
+
+// RecordSession saves a finished play session and reports it to glacier,
+// a failed report only loses the server copy
+func (s *Service) RecordSession(ctx context.Context, session *play.Session) error {
+	err := s.store.AddSession(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	if !s.syncPlaytime {
+		return nil
+	}
+
+	_, err = s.lib.ReportPlaytime(ctx, connect.NewRequest(&librpc.ReportPlaytimeRequest{
+		GameId:   uint64(session.GameId),
+		Seconds:  session.Seconds,
+		PlayedAt: session.Ended.Format(time.RFC3339),
+	}))
+	if err != nil {
+		log.Warn().Err(err).Int("game", session.GameId).Msg("could not report playtime to glacier")
+	}
+	return nil
+}
+
+func resolvePath(base, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(base, path)
+}
+
+// downloaded gets the game if it finished downloading
+func (s *Service) downloaded(ctx context.Context, gameId int) (LocalGame, error) {
+	ll, found, err := s.store.GetByGameId(ctx, gameId)
+	if err != nil {
+		return ll, err
+	}
+	if !found || ll.Download.Status != download.StatusComplete {
+		return ll, fmt.Errorf("game %d is not downloaded", gameId)
+	}
+	return ll, nil
+}
+
+// resumeIncomplete restarts downloads that were running when frost was closed,
+// paused downloads stay paused
+func (s *Service) resumeIncomplete(ctx context.Context) error {
+	games, err := s.ListDownloading(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range games {
+		if g.Download.Status == download.StatusPaused || g.Download.DownloadPath == "" {
+			continue
+		}
+
+		log.Info().Int("game", g.GameId).Msg("resuming download")
+		err = s.downloader.Resume(g.GameId, g.Download.DownloadPath, g.Priority)
+		if err != nil {
+			log.Warn().Err(err).Int("game", g.GameId).Msg("could not resume download")
+		}
+	}
+
+	return nil
 }
 
 func (s *Service) ListDownloading(ctx context.Context) ([]LocalGame, error) {
 	return s.store.ListWithState(
 		ctx,
 		download.StatusDownloading,
+		download.StatusUpdating,
 		download.StatusMetadata,
 		download.StatusQueued,
+		download.StatusPaused,
 	)
 }
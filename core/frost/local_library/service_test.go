@@ -1,8 +1,27 @@
 package download
 
 import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"connectrpc.com/connect"
+	"github.com/cespare/xxhash/v2"
+	"github.com/ra341/glacier/frost/database"
+	"github.com/ra341/glacier/frost/local_library/download"
+	"github.com/ra341/glacier/frost/local_library/install"
+	"github.com/ra341/glacier/frost/local_library/runner"
+	librpc "github.com/ra341/glacier/generated/library/v1"
+	glacier "github.com/ra341/glacier/generated/library/v1/v1connect"
+	indexer "github.com/ra341/glacier/internal/indexer/types"
+	"github.com/ra341/glacier/internal/library"
 	"github.com/ra341/glacier/pkg/logger"
 	"github.com/stretchr/testify/require"
 )
@@ -11,13 +30,89 @@ func init() {
 	logger.InitConsole("debug", true)
 }
 
+var testFiles = map[string][]byte{
+	"game.exe": []byte("aaaabbbbcc"),
+	"data.pak": []byte("dddd"),
+}
+
+// chunks are requested with If-Range set to the modified time of the manifest
+var testModTime = time.Now().Truncate(time.Second)
+
+// fakeGlacier serves the game info and files of every game id
+type fakeGlacier struct {
+	glacier.UnimplementedLibraryServiceHandler
+}
+
+func (fakeGlacier) GetGame(ctx context.Context, req *connect.Request[librpc.GetGameRequest]) (*connect.Response[librpc.GetGameResponse], error) {
+	game := library.Game{Source: indexer.Source{Title: "test", GameType: indexer.Standalone}}
+	game.ID = uint(req.Msg.GameId)
+	return connect.NewResponse(&librpc.GetGameResponse{Game: game.ToProto()}), nil
+}
+
+func newFakeGlacier(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.Handle(glacier.NewLibraryServiceHandler(fakeGlacier{}))
+	mux.HandleFunc("/library/download/meta/", func(w http.ResponseWriter, r *http.Request) {
+		meta := library.FolderManifest{}
+		for rel, contents := range testFiles {
+			meta.FileInfo = append(meta.FileInfo, library.FileManifest{
+				RelPath:  rel,
+				Size:     int64(len(contents)),
+				ModTime:  testModTime,
+				Checksum: strconv.FormatUint(xxhash.Sum64(contents), 10),
+			})
+		}
+		contentType := library.NegotiateManifest(r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", contentType)
+		_ = library.EncodeManifest(w, contentType, &meta)
+	})
+	mux.HandleFunc("/library/download/load/", func(w http.ResponseWriter, r *http.Request) {
+		_, rel, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/library/download/load/"), "/")
+		contents, ok := testFiles[rel]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, rel, testModTime, bytes.NewReader(contents))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
 func TestDownload(t *testing.T) {
-	srv := New("http://localhost:6699", nil)
+	srv := newFakeGlacier(t)
+	dir := t.TempDir()
+	cli := func(transport *http.Transport) *http.Client { return &http.Client{Transport: transport} }
 
-	err := srv.Download(1, "./tmp/download")
-	require.NoError(t, err)
+	store := NewStoreGorm(database.New(dir, false))
+	downloader := download.New(srv.URL, cli, store, download.NewThrottle(download.Bandwidth{}), 1, 2, 2, 0)
+	llSrv := New(
+		srv.URL,
+		store,
+		downloader,
+		install.New(filepath.Join(dir, "logs"), store),
+		runner.New(filepath.Join(dir, "prefixes")),
+		cli,
+		false,
+	)
+
+	gamesDir := filepath.Join(dir, "games")
+	require.NoError(t, llSrv.Download(context.Background(), 1, gamesDir, 0))
 
-	//err = srv.Download(12, "./download")
-	//require.NoError(t, err)
+	// standalone games are ready to play once downloaded
+	var ll LocalGame
+	require.Eventually(t, func() bool {
+		var found bool
+		var err error
+		ll, found, err = store.GetByGameId(context.Background(), 1)
+		require.NoError(t, err)
+		return found && ll.Install.InstallState == install.StateInstalled
+	}, 10*time.Second, 10*time.Millisecond)
 
+	require.Equal(t, download.StatusComplete, ll.Download.Status)
+	contents, err := os.ReadFile(filepath.Join(ll.Download.DownloadPath, "game.exe"))
+	require.NoError(t, err)
+	require.Equal(t, testFiles["game.exe"], contents)
 }
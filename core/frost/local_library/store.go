@@ -2,8 +2,12 @@ package download
 
 import (
 	"context"
+	"time"
 
 	"github.com/ra341/glacier/frost/local_library/download"
+	"github.com/ra341/glacier/frost/local_library/install"
+	"github.com/ra341/glacier/frost/local_library/play"
+	"github.com/ra341/glacier/frost/local_library/runner"
 	v1 "github.com/ra341/glacier/generated/frost_library/v1"
 	"github.com/ra341/glacier/internal/library"
 	"gorm.io/gorm"
@@ -14,15 +18,36 @@ type Store interface {
 	ListWithState(ctx context.Context, status ...download.Status) ([]LocalGame, error)
 
 	Get(ctx context.Context, id int) (LocalGame, error)
+	GetByGameId(ctx context.Context, gameId int) (LocalGame, bool, error)
 	Add(ctx context.Context, game *LocalGame) error
 	Edit(ctx context.Context, id int, game *LocalGame) error
-	EditStatus(ctx context.Context, id int, down *download.Info) error
+	// EditStatus updates the download info of the game with the server game id
+	EditStatus(ctx context.Context, gameId int, down *download.Info) error
+	EditPriority(ctx context.Context, gameId int, priority int) error
+	// EditInstall updates the install info of the game with the server game id
+	EditInstall(ctx context.Context, gameId int, info *install.Info) error
+	EditPlay(ctx context.Context, gameId int, play *GamePlay) error
+	EditRunner(ctx context.Context, gameId int, conf *runner.Config) error
+
+	// AddSession records the session and adds it to the playtime of the game
+	AddSession(ctx context.Context, session *play.Session) error
+	ListSessions(ctx context.Context, gameId int, limit int) ([]play.Session, error)
 	Delete(ctx context.Context, id int) error
 }
 
 type GamePlay struct {
 	InstallerPath string
-	ExePath       string
+	// relative to the install path unless absolute
+	ExePath string
+	Args    []string `gorm:"serializer:json"`
+	// KEY=VALUE pairs
+	Env []string `gorm:"serializer:json"`
+	// defaults to the folder of ExePath
+	WorkDir string
+
+	// total of all sessions
+	PlaytimeSeconds int64
+	LastPlayed      time.Time
 }
 
 type LocalGame struct {
@@ -32,14 +57,40 @@ type LocalGame struct {
 	Game   library.Game `gorm:"embedded"`
 
 	Download download.Info `gorm:"embedded"`
+	Install  install.Info  `gorm:"embedded"`
 	Play     GamePlay      `gorm:"embedded"`
+	Runner   runner.Config `gorm:"embedded"`
+	// queued games with a higher priority start first
+	Priority int
 }
 
 func (g *LocalGame) ToProto() *v1.LocalGame {
 	return &v1.LocalGame{
-		ID:            uint64(g.ID),
-		DownloadPath:  g.Download.DownloadPath,
-		Status:        g.Download.Status.String(),
-		StatusMessage: g.Download.StatusMessage,
+		ID:              uint64(g.ID),
+		DownloadPath:    g.Download.DownloadPath,
+		InstallerPath:   g.Play.InstallerPath,
+		ExePath:         g.Play.ExePath,
+		Status:          g.Download.Status.String(),
+		StatusMessage:   g.Download.StatusMessage,
+		Priority:        int32(g.Priority),
+		InstallState:    g.Install.InstallState.String(),
+		InstallPath:     g.Install.InstallPath,
+		InstallMessage:  g.Install.InstallMessage,
+		Args:            g.Play.Args,
+		Env:             g.Play.Env,
+		WorkDir:         g.Play.WorkDir,
+		PlaytimeSeconds: g.Play.PlaytimeSeconds,
+		LastPlayed:      formatTime(g.Play.LastPlayed),
+		RunnerKind:      g.Runner.RunnerKind.String(),
+		RunnerPath:      g.Runner.RunnerPath,
+		PrefixPath:      g.Runner.PrefixPath,
+	}
+}
+
+// formatTime leaves unset times empty
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
 	}
+	return t.Format(time.RFC3339)
 }
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/ra341/glacier/frost/local_library/download"
+	"github.com/ra341/glacier/frost/local_library/install"
+	"github.com/ra341/glacier/frost/local_library/play"
+	"github.com/ra341/glacier/frost/local_library/runner"
 	"gorm.io/gorm"
 )
 
@@ -42,6 +45,18 @@ func (s *StoreGorm) Get(ctx context.Context, id int) (LocalGame, error) {
 	err := s.db.WithContext(ctx).First(&game, id).Error
 	return game, err
 }
+func (s *StoreGorm) GetByGameId(ctx context.Context, gameId int) (LocalGame, bool, error) {
+	var games []LocalGame
+	err := s.db.WithContext(ctx).
+		Where("game_id = ?", gameId).
+		Limit(1).
+		Find(&games).Error
+	if err != nil || len(games) == 0 {
+		return LocalGame{}, false, err
+	}
+	return games[0], true, nil
+}
+
 func (s *StoreGorm) Edit(ctx context.Context, id int, game *LocalGame) error {
 	game.ID = uint(id)
 	return s.db.WithContext(ctx).Save(game).Error
@@ -51,10 +66,76 @@ func (s *StoreGorm) Delete(ctx context.Context, id int) error {
 	return s.db.WithContext(ctx).Unscoped().Delete(&LocalGame{}, id).Error
 }
 
-func (s *StoreGorm) EditStatus(ctx context.Context, id int, down *download.Info) error {
+func (s *StoreGorm) EditStatus(ctx context.Context, gameId int, down *download.Info) error {
 	return s.db.WithContext(ctx).
 		Model(&LocalGame{}).
-		Where("id = ?", id).
+		Where("game_id = ?", gameId).
 		Updates(down).
 		Error
 }
+
+func (s *StoreGorm) EditPriority(ctx context.Context, gameId int, priority int) error {
+	return s.db.WithContext(ctx).
+		Model(&LocalGame{}).
+		Where("game_id = ?", gameId).
+		Update("priority", priority).
+		Error
+}
+
+func (s *StoreGorm) EditInstall(ctx context.Context, gameId int, info *install.Info) error {
+	return s.db.WithContext(ctx).
+		Model(&LocalGame{}).
+		Where("game_id = ?", gameId).
+		// an empty path or message replaces the previous one
+		Select("install_state", "install_path", "install_message", "installed_at").
+		Updates(info).
+		Error
+}
+
+func (s *StoreGorm) EditPlay(ctx context.Context, gameId int, play *GamePlay) error {
+	return s.db.WithContext(ctx).
+		Model(&LocalGame{}).
+		Where("game_id = ?", gameId).
+		// empty args and env clear the previous ones
+		Select("exe_path", "args", "env", "work_dir").
+		Updates(&LocalGame{Play: *play}).
+		Error
+}
+
+func (s *StoreGorm) EditRunner(ctx context.Context, gameId int, conf *runner.Config) error {
+	return s.db.WithContext(ctx).
+		Model(&LocalGame{}).
+		Where("game_id = ?", gameId).
+		// switching to native clears the paths
+		Select("runner_kind", "runner_path", "prefix_path").
+		Updates(&LocalGame{Runner: *conf}).
+		Error
+}
+
+func (s *StoreGorm) AddSession(ctx context.Context, session *play.Session) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(session).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&LocalGame{}).
+			Where("game_id = ?", session.GameId).
+			Updates(map[string]any{
+				"playtime_seconds": gorm.Expr("COALESCE(playtime_seconds, 0) + ?", session.Seconds),
+				"last_played":      session.Ended,
+			}).
+			Error
+	})
+}
+
+func (s *StoreGorm) ListSessions(ctx context.Context, gameId int, limit int) ([]play.Session, error) {
+	var sessions []play.Session
+	err := s.db.WithContext(ctx).
+		Where("game_id = ?", gameId).
+		Order("started desc").
+		Limit(limit).
+		Find(&sessions).
+		Error
+	return sessions, err
+}
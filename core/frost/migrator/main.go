@@ -7,6 +7,7 @@ import (
 
 	"ariga.io/atlas-provider-gorm/gormschema"
 	ll "github.com/ra341/glacier/frost/local_library"
+	"github.com/ra341/glacier/frost/local_library/play"
 )
 
 func main() {
@@ -14,6 +15,7 @@ func main() {
 		New("sqlite").
 		Load(
 			&ll.LocalGame{},
+			&play.Session{},
 		)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to load gorm schema: %v\n", err)
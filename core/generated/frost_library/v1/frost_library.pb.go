@@ -161,6 +161,22 @@ type LocalGame struct {
 	ExePath       string                 `protobuf:"bytes,4,opt,name=ExePath,proto3" json:"ExePath,omitempty"`
 	Status        string                 `protobuf:"bytes,5,opt,name=Status,proto3" json:"Status,omitempty"`
 	StatusMessage string                 `protobuf:"bytes,6,opt,name=StatusMessage,proto3" json:"StatusMessage,omitempty"`
+	Priority      int32                  `protobuf:"varint,7,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	InstallState  string                 `protobuf:"bytes,8,opt,name=InstallState,proto3" json:"InstallState,omitempty"`
+	// where the game was installed, the download path for games without an installer
+	InstallPath    string   `protobuf:"bytes,9,opt,name=InstallPath,proto3" json:"InstallPath,omitempty"`
+	InstallMessage string   `protobuf:"bytes,10,opt,name=InstallMessage,proto3" json:"InstallMessage,omitempty"`
+	Args           []string `protobuf:"bytes,11,rep,name=Args,proto3" json:"Args,omitempty"`
+	// KEY=VALUE pairs
+	Env             []string `protobuf:"bytes,12,rep,name=Env,proto3" json:"Env,omitempty"`
+	WorkDir         string   `protobuf:"bytes,13,opt,name=WorkDir,proto3" json:"WorkDir,omitempty"`
+	PlaytimeSeconds int64    `protobuf:"varint,14,opt,name=PlaytimeSeconds,proto3" json:"PlaytimeSeconds,omitempty"`
+	// RFC3339, empty if never played
+	LastPlayed string `protobuf:"bytes,15,opt,name=LastPlayed,proto3" json:"LastPlayed,omitempty"`
+	// Native, Wine or Proton
+	RunnerKind    string `protobuf:"bytes,16,opt,name=RunnerKind,proto3" json:"RunnerKind,omitempty"`
+	RunnerPath    string `protobuf:"bytes,17,opt,name=RunnerPath,proto3" json:"RunnerPath,omitempty"`
+	PrefixPath    string `protobuf:"bytes,18,opt,name=PrefixPath,proto3" json:"PrefixPath,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -237,6 +253,90 @@ func (x *LocalGame) GetStatusMessage() string {
 	return ""
 }
 
+func (x *LocalGame) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *LocalGame) GetInstallState() string {
+	if x != nil {
+		return x.InstallState
+	}
+	return ""
+}
+
+func (x *LocalGame) GetInstallPath() string {
+	if x != nil {
+		return x.InstallPath
+	}
+	return ""
+}
+
+func (x *LocalGame) GetInstallMessage() string {
+	if x != nil {
+		return x.InstallMessage
+	}
+	return ""
+}
+
+func (x *LocalGame) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *LocalGame) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *LocalGame) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+func (x *LocalGame) GetPlaytimeSeconds() int64 {
+	if x != nil {
+		return x.PlaytimeSeconds
+	}
+	return 0
+}
+
+func (x *LocalGame) GetLastPlayed() string {
+	if x != nil {
+		return x.LastPlayed
+	}
+	return ""
+}
+
+func (x *LocalGame) GetRunnerKind() string {
+	if x != nil {
+		return x.RunnerKind
+	}
+	return ""
+}
+
+func (x *LocalGame) GetRunnerPath() string {
+	if x != nil {
+		return x.RunnerPath
+	}
+	return ""
+}
+
+func (x *LocalGame) GetPrefixPath() string {
+	if x != nil {
+		return x.PrefixPath
+	}
+	return ""
+}
+
 type GetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lg            *LocalGame             `protobuf:"bytes,1,opt,name=lg,proto3" json:"lg,omitempty"`
@@ -365,8 +465,10 @@ type DownloadRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	GameId         int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
 	DownloadFolder string                 `protobuf:"bytes,2,opt,name=downloadFolder,proto3" json:"downloadFolder,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// queued games with a higher priority start first
+	Priority      int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadRequest) Reset() {
@@ -413,6 +515,13 @@ func (x *DownloadRequest) GetDownloadFolder() string {
 	return ""
 }
 
+func (x *DownloadRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 type DownloadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -449,26 +558,27 @@ func (*DownloadResponse) Descriptor() ([]byte, []int) {
 	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{8}
 }
 
-type ListDownloadingRequest struct {
+type PauseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDownloadingRequest) Reset() {
-	*x = ListDownloadingRequest{}
+func (x *PauseRequest) Reset() {
+	*x = PauseRequest{}
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDownloadingRequest) String() string {
+func (x *PauseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDownloadingRequest) ProtoMessage() {}
+func (*PauseRequest) ProtoMessage() {}
 
-func (x *ListDownloadingRequest) ProtoReflect() protoreflect.Message {
+func (x *PauseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,34 +590,38 @@ func (x *ListDownloadingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDownloadingRequest.ProtoReflect.Descriptor instead.
-func (*ListDownloadingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseRequest.ProtoReflect.Descriptor instead.
+func (*PauseRequest) Descriptor() ([]byte, []int) {
 	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{9}
 }
 
-type FileProgress struct {
+func (x *PauseRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type PauseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Complete      uint64                 `protobuf:"varint,2,opt,name=Complete,proto3" json:"Complete,omitempty"`
-	Left          uint64                 `protobuf:"varint,3,opt,name=Left,proto3" json:"Left,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileProgress) Reset() {
-	*x = FileProgress{}
+func (x *PauseResponse) Reset() {
+	*x = PauseResponse{}
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileProgress) String() string {
+func (x *PauseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileProgress) ProtoMessage() {}
+func (*PauseResponse) ProtoMessage() {}
 
-func (x *FileProgress) ProtoReflect() protoreflect.Message {
+func (x *PauseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -519,55 +633,32 @@ func (x *FileProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileProgress.ProtoReflect.Descriptor instead.
-func (*FileProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseResponse.ProtoReflect.Descriptor instead.
+func (*PauseResponse) Descriptor() ([]byte, []int) {
 	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FileProgress) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *FileProgress) GetComplete() uint64 {
-	if x != nil {
-		return x.Complete
-	}
-	return 0
-}
-
-func (x *FileProgress) GetLeft() uint64 {
-	if x != nil {
-		return x.Left
-	}
-	return 0
-}
-
-type FolderProgress struct {
+type ResumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Complete      int64                  `protobuf:"varint,1,opt,name=Complete,proto3" json:"Complete,omitempty"`
-	Left          int64                  `protobuf:"varint,2,opt,name=Left,proto3" json:"Left,omitempty"`
-	Files         []*FileProgress        `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FolderProgress) Reset() {
-	*x = FolderProgress{}
+func (x *ResumeRequest) Reset() {
+	*x = ResumeRequest{}
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FolderProgress) String() string {
+func (x *ResumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FolderProgress) ProtoMessage() {}
+func (*ResumeRequest) ProtoMessage() {}
 
-func (x *FolderProgress) ProtoReflect() protoreflect.Message {
+func (x *ResumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -579,56 +670,38 @@ func (x *FolderProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FolderProgress.ProtoReflect.Descriptor instead.
-func (*FolderProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResumeRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
 	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *FolderProgress) GetComplete() int64 {
-	if x != nil {
-		return x.Complete
-	}
-	return 0
-}
-
-func (x *FolderProgress) GetLeft() int64 {
+func (x *ResumeRequest) GetGameId() int64 {
 	if x != nil {
-		return x.Left
+		return x.GameId
 	}
 	return 0
 }
 
-func (x *FolderProgress) GetFiles() []*FileProgress {
-	if x != nil {
-		return x.Files
-	}
-	return nil
-}
-
-type DownloadProgress struct {
+type ResumeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Thumbnail     string                 `protobuf:"bytes,1,opt,name=Thumbnail,proto3" json:"Thumbnail,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Download      *DownloadInf           `protobuf:"bytes,3,opt,name=download,proto3" json:"download,omitempty"`
-	Progress      *FolderProgress        `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadProgress) Reset() {
-	*x = DownloadProgress{}
+func (x *ResumeResponse) Reset() {
+	*x = ResumeResponse{}
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadProgress) String() string {
+func (x *ResumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadProgress) ProtoMessage() {}
+func (*ResumeResponse) ProtoMessage() {}
 
-func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
+func (x *ResumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_frost_library_v1_frost_library_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -640,64 +713,77 @@ func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadProgress.ProtoReflect.Descriptor instead.
-func (*DownloadProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResumeResponse.ProtoReflect.Descriptor instead.
+func (*ResumeResponse) Descriptor() ([]byte, []int) {
 	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DownloadProgress) GetThumbnail() string {
-	if x != nil {
-		return x.Thumbnail
-	}
-	return ""
+// stops the download and deletes the downloaded files
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadProgress) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadProgress) GetDownload() *DownloadInf {
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[13]
 	if x != nil {
-		return x.Download
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DownloadProgress) GetProgress() *FolderProgress {
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelRequest) GetGameId() int64 {
 	if x != nil {
-		return x.Progress
+		return x.GameId
 	}
-	return nil
+	return 0
 }
 
-type DownloadInf struct {
+type CancelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         string                 `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
-	TimeStarted   string                 `protobuf:"bytes,3,opt,name=TimeStarted,proto3" json:"TimeStarted,omitempty"`
-	DownloadPath  string                 `protobuf:"bytes,4,opt,name=DownloadPath,proto3" json:"DownloadPath,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadInf) Reset() {
-	*x = DownloadInf{}
-	mi := &file_frost_library_v1_frost_library_proto_msgTypes[13]
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadInf) String() string {
+func (x *CancelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadInf) ProtoMessage() {}
+func (*CancelResponse) ProtoMessage() {}
 
-func (x *DownloadInf) ProtoReflect() protoreflect.Message {
-	mi := &file_frost_library_v1_frost_library_proto_msgTypes[13]
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,61 +794,554 @@ func (x *DownloadInf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadInf.ProtoReflect.Descriptor instead.
-func (*DownloadInf) Descriptor() ([]byte, []int) {
-	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DownloadInf) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+type SetPriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadInf) GetMessage() string {
+func (x *SetPriorityRequest) Reset() {
+	*x = SetPriorityRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriorityRequest) ProtoMessage() {}
+
+func (x *SetPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[15]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DownloadInf) GetTimeStarted() string {
+// Deprecated: Use SetPriorityRequest.ProtoReflect.Descriptor instead.
+func (*SetPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetPriorityRequest) GetGameId() int64 {
 	if x != nil {
-		return x.TimeStarted
+		return x.GameId
 	}
-	return ""
+	return 0
 }
 
-func (x *DownloadInf) GetDownloadPath() string {
+func (x *SetPriorityRequest) GetPriority() int32 {
 	if x != nil {
-		return x.DownloadPath
+		return x.Priority
 	}
-	return ""
+	return 0
 }
 
-type ListDownloadingResponse struct {
+type SetPriorityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Downloads     []*DownloadProgress    `protobuf:"bytes,1,rep,name=downloads,proto3" json:"downloads,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDownloadingResponse) Reset() {
-	*x = ListDownloadingResponse{}
-	mi := &file_frost_library_v1_frost_library_proto_msgTypes[14]
+func (x *SetPriorityResponse) Reset() {
+	*x = SetPriorityResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDownloadingResponse) String() string {
+func (x *SetPriorityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDownloadingResponse) ProtoMessage() {}
+func (*SetPriorityResponse) ProtoMessage() {}
 
-func (x *ListDownloadingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frost_library_v1_frost_library_proto_msgTypes[14]
+func (x *SetPriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriorityResponse.ProtoReflect.Descriptor instead.
+func (*SetPriorityResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{16}
+}
+
+type ListQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueRequest) Reset() {
+	*x = ListQueueRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueRequest) ProtoMessage() {}
+
+func (x *ListQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{17}
+}
+
+type QueueEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GameId   int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Priority int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	// 0 is the next game to start
+	Position      uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEntry) ProtoMessage() {}
+
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueueEntry) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ListQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*QueueEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueResponse) Reset() {
+	*x = ListQueueResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueResponse) ProtoMessage() {}
+
+func (x *ListQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListQueueResponse) GetEntries() []*QueueEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ListDownloadingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDownloadingRequest) Reset() {
+	*x = ListDownloadingRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDownloadingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadingRequest) ProtoMessage() {}
+
+func (x *ListDownloadingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDownloadingRequest.ProtoReflect.Descriptor instead.
+func (*ListDownloadingRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{20}
+}
+
+type FileProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Complete      uint64                 `protobuf:"varint,2,opt,name=Complete,proto3" json:"Complete,omitempty"`
+	Left          uint64                 `protobuf:"varint,3,opt,name=Left,proto3" json:"Left,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileProgress) Reset() {
+	*x = FileProgress{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileProgress) ProtoMessage() {}
+
+func (x *FileProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileProgress.ProtoReflect.Descriptor instead.
+func (*FileProgress) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FileProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileProgress) GetComplete() uint64 {
+	if x != nil {
+		return x.Complete
+	}
+	return 0
+}
+
+func (x *FileProgress) GetLeft() uint64 {
+	if x != nil {
+		return x.Left
+	}
+	return 0
+}
+
+type FolderProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Complete      int64                  `protobuf:"varint,1,opt,name=Complete,proto3" json:"Complete,omitempty"`
+	Left          int64                  `protobuf:"varint,2,opt,name=Left,proto3" json:"Left,omitempty"`
+	Files         []*FileProgress        `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FolderProgress) Reset() {
+	*x = FolderProgress{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FolderProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderProgress) ProtoMessage() {}
+
+func (x *FolderProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderProgress.ProtoReflect.Descriptor instead.
+func (*FolderProgress) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FolderProgress) GetComplete() int64 {
+	if x != nil {
+		return x.Complete
+	}
+	return 0
+}
+
+func (x *FolderProgress) GetLeft() int64 {
+	if x != nil {
+		return x.Left
+	}
+	return 0
+}
+
+func (x *FolderProgress) GetFiles() []*FileProgress {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type DownloadProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Thumbnail     string                 `protobuf:"bytes,1,opt,name=Thumbnail,proto3" json:"Thumbnail,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Download      *DownloadInf           `protobuf:"bytes,3,opt,name=download,proto3" json:"download,omitempty"`
+	Progress      *FolderProgress        `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadProgress) Reset() {
+	*x = DownloadProgress{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadProgress) ProtoMessage() {}
+
+func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadProgress.ProtoReflect.Descriptor instead.
+func (*DownloadProgress) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DownloadProgress) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+func (x *DownloadProgress) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DownloadProgress) GetDownload() *DownloadInf {
+	if x != nil {
+		return x.Download
+	}
+	return nil
+}
+
+func (x *DownloadProgress) GetProgress() *FolderProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type DownloadInf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	TimeStarted   string                 `protobuf:"bytes,3,opt,name=TimeStarted,proto3" json:"TimeStarted,omitempty"`
+	DownloadPath  string                 `protobuf:"bytes,4,opt,name=DownloadPath,proto3" json:"DownloadPath,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadInf) Reset() {
+	*x = DownloadInf{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadInf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInf) ProtoMessage() {}
+
+func (x *DownloadInf) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInf.ProtoReflect.Descriptor instead.
+func (*DownloadInf) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DownloadInf) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DownloadInf) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DownloadInf) GetTimeStarted() string {
+	if x != nil {
+		return x.TimeStarted
+	}
+	return ""
+}
+
+func (x *DownloadInf) GetDownloadPath() string {
+	if x != nil {
+		return x.DownloadPath
+	}
+	return ""
+}
+
+type ListDownloadingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Downloads     []*DownloadProgress    `protobuf:"bytes,1,rep,name=downloads,proto3" json:"downloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDownloadingResponse) Reset() {
+	*x = ListDownloadingResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDownloadingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadingResponse) ProtoMessage() {}
+
+func (x *ListDownloadingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,12 +1354,1913 @@ func (x *ListDownloadingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDownloadingResponse.ProtoReflect.Descriptor instead.
 func (*ListDownloadingResponse) Descriptor() ([]byte, []int) {
-	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{14}
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDownloadingResponse) GetDownloads() []*DownloadProgress {
+	if x != nil {
+		return x.Downloads
+	}
+	return nil
+}
+
+// limits are in KB/s, 0 is unlimited
+type Bandwidth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shared by every download outside the schedule
+	LimitKb int64 `protobuf:"varint,1,opt,name=limitKb,proto3" json:"limitKb,omitempty"`
+	// applies to each download on its own
+	GameLimitKb int64 `protobuf:"varint,2,opt,name=gameLimitKb,proto3" json:"gameLimitKb,omitempty"`
+	// overrides limitKb while a window is active, the first matching window wins
+	Schedule      []*SpeedWindow `protobuf:"bytes,3,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bandwidth) Reset() {
+	*x = Bandwidth{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bandwidth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bandwidth) ProtoMessage() {}
+
+func (x *Bandwidth) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bandwidth.ProtoReflect.Descriptor instead.
+func (*Bandwidth) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Bandwidth) GetLimitKb() int64 {
+	if x != nil {
+		return x.LimitKb
+	}
+	return 0
+}
+
+func (x *Bandwidth) GetGameLimitKb() int64 {
+	if x != nil {
+		return x.GameLimitKb
+	}
+	return 0
+}
+
+func (x *Bandwidth) GetSchedule() []*SpeedWindow {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// a window ending before it starts runs past midnight
+type SpeedWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HH:MM
+	Start         string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	LimitKb       int64  `protobuf:"varint,3,opt,name=limitKb,proto3" json:"limitKb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeedWindow) Reset() {
+	*x = SpeedWindow{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeedWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedWindow) ProtoMessage() {}
+
+func (x *SpeedWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedWindow.ProtoReflect.Descriptor instead.
+func (*SpeedWindow) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SpeedWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *SpeedWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *SpeedWindow) GetLimitKb() int64 {
+	if x != nil {
+		return x.LimitKb
+	}
+	return 0
+}
+
+type GetBandwidthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBandwidthRequest) Reset() {
+	*x = GetBandwidthRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBandwidthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBandwidthRequest) ProtoMessage() {}
+
+func (x *GetBandwidthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBandwidthRequest.ProtoReflect.Descriptor instead.
+func (*GetBandwidthRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{28}
+}
+
+type GetBandwidthResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Bandwidth *Bandwidth             `protobuf:"bytes,1,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	// limit shared by all downloads right now
+	CurrentLimitKb int64 `protobuf:"varint,2,opt,name=currentLimitKb,proto3" json:"currentLimitKb,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBandwidthResponse) Reset() {
+	*x = GetBandwidthResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBandwidthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBandwidthResponse) ProtoMessage() {}
+
+func (x *GetBandwidthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBandwidthResponse.ProtoReflect.Descriptor instead.
+func (*GetBandwidthResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBandwidthResponse) GetBandwidth() *Bandwidth {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return nil
+}
+
+func (x *GetBandwidthResponse) GetCurrentLimitKb() int64 {
+	if x != nil {
+		return x.CurrentLimitKb
+	}
+	return 0
+}
+
+type SetBandwidthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bandwidth     *Bandwidth             `protobuf:"bytes,1,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBandwidthRequest) Reset() {
+	*x = SetBandwidthRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBandwidthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBandwidthRequest) ProtoMessage() {}
+
+func (x *SetBandwidthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBandwidthRequest.ProtoReflect.Descriptor instead.
+func (*SetBandwidthRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetBandwidthRequest) GetBandwidth() *Bandwidth {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return nil
+}
+
+type SetBandwidthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBandwidthResponse) Reset() {
+	*x = SetBandwidthResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBandwidthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBandwidthResponse) ProtoMessage() {}
+
+func (x *SetBandwidthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBandwidthResponse.ProtoReflect.Descriptor instead.
+func (*SetBandwidthResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{31}
+}
+
+type WatchProgressRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only send these games, all games when empty
+	GameIds       []int64 `protobuf:"varint,1,rep,packed,name=gameIds,proto3" json:"gameIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProgressRequest) Reset() {
+	*x = WatchProgressRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProgressRequest) ProtoMessage() {}
+
+func (x *WatchProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProgressRequest.ProtoReflect.Descriptor instead.
+func (*WatchProgressRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchProgressRequest) GetGameIds() []int64 {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+type WatchProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StatusMessage string                 `protobuf:"bytes,3,opt,name=statusMessage,proto3" json:"statusMessage,omitempty"`
+	// file of the finished chunk, empty for status changes
+	File         string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	FileComplete int64  `protobuf:"varint,5,opt,name=fileComplete,proto3" json:"fileComplete,omitempty"`
+	FileSize     int64  `protobuf:"varint,6,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	// bytes of the whole game
+	Complete    int64 `protobuf:"varint,7,opt,name=complete,proto3" json:"complete,omitempty"`
+	Total       int64 `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`
+	BytesPerSec int64 `protobuf:"varint,9,opt,name=bytesPerSec,proto3" json:"bytesPerSec,omitempty"`
+	// 0 until the speed is known
+	EtaSeconds    int64 `protobuf:"varint,10,opt,name=etaSeconds,proto3" json:"etaSeconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProgressResponse) Reset() {
+	*x = WatchProgressResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProgressResponse) ProtoMessage() {}
+
+func (x *WatchProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProgressResponse.ProtoReflect.Descriptor instead.
+func (*WatchProgressResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchProgressResponse) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *WatchProgressResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WatchProgressResponse) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *WatchProgressResponse) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *WatchProgressResponse) GetFileComplete() int64 {
+	if x != nil {
+		return x.FileComplete
+	}
+	return 0
+}
+
+func (x *WatchProgressResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *WatchProgressResponse) GetComplete() int64 {
+	if x != nil {
+		return x.Complete
+	}
+	return 0
+}
+
+func (x *WatchProgressResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WatchProgressResponse) GetBytesPerSec() int64 {
+	if x != nil {
+		return x.BytesPerSec
+	}
+	return 0
+}
+
+func (x *WatchProgressResponse) GetEtaSeconds() int64 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+type ListInstallersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallersRequest) Reset() {
+	*x = ListInstallersRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallersRequest) ProtoMessage() {}
+
+func (x *ListInstallersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallersRequest.ProtoReflect.Descriptor instead.
+func (*ListInstallersRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListInstallersRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type Installer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// relative to the download path
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// exe, msi, shell or AppImage
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Installer) Reset() {
+	*x = Installer{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Installer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installer) ProtoMessage() {}
+
+func (x *Installer) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Installer.ProtoReflect.Descriptor instead.
+func (*Installer) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Installer) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Installer) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type ListInstallersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Installers    []*Installer           `protobuf:"bytes,1,rep,name=installers,proto3" json:"installers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallersResponse) Reset() {
+	*x = ListInstallersResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallersResponse) ProtoMessage() {}
+
+func (x *ListInstallersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallersResponse.ProtoReflect.Descriptor instead.
+func (*ListInstallersResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListInstallersResponse) GetInstallers() []*Installer {
+	if x != nil {
+		return x.Installers
+	}
+	return nil
+}
+
+type InstallRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	// one of the paths from ListInstallers
+	InstallerPath string `protobuf:"bytes,2,opt,name=installerPath,proto3" json:"installerPath,omitempty"`
+	// saved as the install location, defaults to the download path
+	InstallDir    string `protobuf:"bytes,3,opt,name=installDir,proto3" json:"installDir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallRequest) Reset() {
+	*x = InstallRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallRequest) ProtoMessage() {}
+
+func (x *InstallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallRequest.ProtoReflect.Descriptor instead.
+func (*InstallRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InstallRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *InstallRequest) GetInstallerPath() string {
+	if x != nil {
+		return x.InstallerPath
+	}
+	return ""
+}
+
+func (x *InstallRequest) GetInstallDir() string {
+	if x != nil {
+		return x.InstallDir
+	}
+	return ""
+}
+
+type InstallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallResponse) Reset() {
+	*x = InstallResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallResponse) ProtoMessage() {}
+
+func (x *InstallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallResponse.ProtoReflect.Descriptor instead.
+func (*InstallResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{38}
+}
+
+type CancelInstallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInstallRequest) Reset() {
+	*x = CancelInstallRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInstallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInstallRequest) ProtoMessage() {}
+
+func (x *CancelInstallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInstallRequest.ProtoReflect.Descriptor instead.
+func (*CancelInstallRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelInstallRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type CancelInstallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInstallResponse) Reset() {
+	*x = CancelInstallResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInstallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInstallResponse) ProtoMessage() {}
+
+func (x *CancelInstallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInstallResponse.ProtoReflect.Descriptor instead.
+func (*CancelInstallResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{40}
+}
+
+type GetInstallLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallLogRequest) Reset() {
+	*x = GetInstallLogRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallLogRequest) ProtoMessage() {}
+
+func (x *GetInstallLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallLogRequest.ProtoReflect.Descriptor instead.
+func (*GetInstallLogRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetInstallLogRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type GetInstallLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// output of the last installer run
+	Log           string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallLogResponse) Reset() {
+	*x = GetInstallLogResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallLogResponse) ProtoMessage() {}
+
+func (x *GetInstallLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallLogResponse.ProtoReflect.Descriptor instead.
+func (*GetInstallLogResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetInstallLogResponse) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+type SetLaunchOptionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	// relative to the install path unless absolute
+	ExePath string   `protobuf:"bytes,2,opt,name=exePath,proto3" json:"exePath,omitempty"`
+	Args    []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// KEY=VALUE pairs
+	Env []string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
+	// relative to the install path unless absolute, defaults to the folder of exePath
+	WorkDir       string `protobuf:"bytes,5,opt,name=workDir,proto3" json:"workDir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLaunchOptionsRequest) Reset() {
+	*x = SetLaunchOptionsRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLaunchOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLaunchOptionsRequest) ProtoMessage() {}
+
+func (x *SetLaunchOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLaunchOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetLaunchOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetLaunchOptionsRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SetLaunchOptionsRequest) GetExePath() string {
+	if x != nil {
+		return x.ExePath
+	}
+	return ""
+}
+
+func (x *SetLaunchOptionsRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *SetLaunchOptionsRequest) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *SetLaunchOptionsRequest) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+type SetLaunchOptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLaunchOptionsResponse) Reset() {
+	*x = SetLaunchOptionsResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLaunchOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLaunchOptionsResponse) ProtoMessage() {}
+
+func (x *SetLaunchOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLaunchOptionsResponse.ProtoReflect.Descriptor instead.
+func (*SetLaunchOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{44}
+}
+
+type LaunchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaunchRequest) Reset() {
+	*x = LaunchRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchRequest) ProtoMessage() {}
+
+func (x *LaunchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchRequest.ProtoReflect.Descriptor instead.
+func (*LaunchRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LaunchRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type LaunchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaunchResponse) Reset() {
+	*x = LaunchResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchResponse) ProtoMessage() {}
+
+func (x *LaunchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchResponse.ProtoReflect.Descriptor instead.
+func (*LaunchResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{46}
+}
+
+type StopGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopGameRequest) Reset() {
+	*x = StopGameRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopGameRequest) ProtoMessage() {}
+
+func (x *StopGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopGameRequest.ProtoReflect.Descriptor instead.
+func (*StopGameRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StopGameRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type StopGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopGameResponse) Reset() {
+	*x = StopGameResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopGameResponse) ProtoMessage() {}
+
+func (x *StopGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopGameResponse.ProtoReflect.Descriptor instead.
+func (*StopGameResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{48}
+}
+
+type ListRunningRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunningRequest) Reset() {
+	*x = ListRunningRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunningRequest) ProtoMessage() {}
+
+func (x *ListRunningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunningRequest.ProtoReflect.Descriptor instead.
+func (*ListRunningRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{49}
+}
+
+type ListRunningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameIds       []int64                `protobuf:"varint,1,rep,packed,name=gameIds,proto3" json:"gameIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunningResponse) Reset() {
+	*x = ListRunningResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunningResponse) ProtoMessage() {}
+
+func (x *ListRunningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunningResponse.ProtoReflect.Descriptor instead.
+func (*ListRunningResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListRunningResponse) GetGameIds() []int64 {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	// defaults to 50
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListSessionsRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PlaySession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC3339
+	Started       string `protobuf:"bytes,1,opt,name=started,proto3" json:"started,omitempty"`
+	Ended         string `protobuf:"bytes,2,opt,name=ended,proto3" json:"ended,omitempty"`
+	Seconds       int64  `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	ExitCode      int32  `protobuf:"varint,4,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaySession) Reset() {
+	*x = PlaySession{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaySession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaySession) ProtoMessage() {}
+
+func (x *PlaySession) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaySession.ProtoReflect.Descriptor instead.
+func (*PlaySession) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PlaySession) GetStarted() string {
+	if x != nil {
+		return x.Started
+	}
+	return ""
+}
+
+func (x *PlaySession) GetEnded() string {
+	if x != nil {
+		return x.Ended
+	}
+	return ""
+}
+
+func (x *PlaySession) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *PlaySession) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*PlaySession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	TotalSeconds  int64                  `protobuf:"varint,2,opt,name=totalSeconds,proto3" json:"totalSeconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*PlaySession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+type Runner struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Wine or Proton
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// wine binary or proton script
+	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Version       string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Runner) Reset() {
+	*x = Runner{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Runner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Runner) ProtoMessage() {}
+
+func (x *Runner) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Runner.ProtoReflect.Descriptor instead.
+func (*Runner) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Runner) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Runner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Runner) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Runner) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ListRunnersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunnersRequest) Reset() {
+	*x = ListRunnersRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunnersRequest) ProtoMessage() {}
+
+func (x *ListRunnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunnersRequest.ProtoReflect.Descriptor instead.
+func (*ListRunnersRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{55}
+}
+
+type ListRunnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runners       []*Runner              `protobuf:"bytes,1,rep,name=runners,proto3" json:"runners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunnersResponse) Reset() {
+	*x = ListRunnersResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunnersResponse) ProtoMessage() {}
+
+func (x *ListRunnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ListDownloadingResponse) GetDownloads() []*DownloadProgress {
+// Deprecated: Use ListRunnersResponse.ProtoReflect.Descriptor instead.
+func (*ListRunnersResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListRunnersResponse) GetRunners() []*Runner {
 	if x != nil {
-		return x.Downloads
+		return x.Runners
+	}
+	return nil
+}
+
+type SetRunnerRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	// Native, Wine or Proton
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// empty uses the first runner of the kind found
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// empty uses the prefix frost keeps for the game
+	PrefixPath    string `protobuf:"bytes,4,opt,name=prefixPath,proto3" json:"prefixPath,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRunnerRequest) Reset() {
+	*x = SetRunnerRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRunnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRunnerRequest) ProtoMessage() {}
+
+func (x *SetRunnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRunnerRequest.ProtoReflect.Descriptor instead.
+func (*SetRunnerRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetRunnerRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SetRunnerRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetRunnerRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetRunnerRequest) GetPrefixPath() string {
+	if x != nil {
+		return x.PrefixPath
+	}
+	return ""
+}
+
+type SetRunnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRunnerResponse) Reset() {
+	*x = SetRunnerResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRunnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRunnerResponse) ProtoMessage() {}
+
+func (x *SetRunnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRunnerResponse.ProtoReflect.Descriptor instead.
+func (*SetRunnerResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{58}
+}
+
+// paths are relative to the download path
+type VerifyReport struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Missing  []string               `protobuf:"bytes,1,rep,name=missing,proto3" json:"missing,omitempty"`
+	Modified []string               `protobuf:"bytes,2,rep,name=modified,proto3" json:"modified,omitempty"`
+	// files that are not on the server, they are never removed
+	Extra         []string `protobuf:"bytes,3,rep,name=extra,proto3" json:"extra,omitempty"`
+	Verified      int64    `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyReport) Reset() {
+	*x = VerifyReport{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyReport) ProtoMessage() {}
+
+func (x *VerifyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyReport.ProtoReflect.Descriptor instead.
+func (*VerifyReport) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *VerifyReport) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *VerifyReport) GetModified() []string {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *VerifyReport) GetExtra() []string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *VerifyReport) GetVerified() int64 {
+	if x != nil {
+		return x.Verified
+	}
+	return 0
+}
+
+type VerifyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *VerifyRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type VerifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *VerifyReport          `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResponse) Reset() {
+	*x = VerifyResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResponse) ProtoMessage() {}
+
+func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *VerifyResponse) GetReport() *VerifyReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type RepairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairRequest) Reset() {
+	*x = RepairRequest{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairRequest) ProtoMessage() {}
+
+func (x *RepairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairRequest.ProtoReflect.Descriptor instead.
+func (*RepairRequest) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RepairRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type RepairResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the files being downloaded again, empty when nothing was wrong
+	Report        *VerifyReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairResponse) Reset() {
+	*x = RepairResponse{}
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairResponse) ProtoMessage() {}
+
+func (x *RepairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frost_library_v1_frost_library_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairResponse.ProtoReflect.Descriptor instead.
+func (*RepairResponse) Descriptor() ([]byte, []int) {
+	return file_frost_library_v1_frost_library_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RepairResponse) GetReport() *VerifyReport {
+	if x != nil {
+		return x.Report
 	}
 	return nil
 }
@@ -796,23 +3276,66 @@ const file_frost_library_v1_frost_library_proto_rawDesc = "" +
 	"\x11ListFilesResponse\"\x1c\n" +
 	"\n" +
 	"GetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\xbd\x01\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xb1\x04\n" +
 	"\tLocalGame\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\"\n" +
 	"\fDownloadPath\x18\x02 \x01(\tR\fDownloadPath\x12$\n" +
 	"\rInstallerPath\x18\x03 \x01(\tR\rInstallerPath\x12\x18\n" +
 	"\aExePath\x18\x04 \x01(\tR\aExePath\x12\x16\n" +
 	"\x06Status\x18\x05 \x01(\tR\x06Status\x12$\n" +
-	"\rStatusMessage\x18\x06 \x01(\tR\rStatusMessage\":\n" +
+	"\rStatusMessage\x18\x06 \x01(\tR\rStatusMessage\x12\x1a\n" +
+	"\bPriority\x18\a \x01(\x05R\bPriority\x12\"\n" +
+	"\fInstallState\x18\b \x01(\tR\fInstallState\x12 \n" +
+	"\vInstallPath\x18\t \x01(\tR\vInstallPath\x12&\n" +
+	"\x0eInstallMessage\x18\n" +
+	" \x01(\tR\x0eInstallMessage\x12\x12\n" +
+	"\x04Args\x18\v \x03(\tR\x04Args\x12\x10\n" +
+	"\x03Env\x18\f \x03(\tR\x03Env\x12\x18\n" +
+	"\aWorkDir\x18\r \x01(\tR\aWorkDir\x12(\n" +
+	"\x0fPlaytimeSeconds\x18\x0e \x01(\x03R\x0fPlaytimeSeconds\x12\x1e\n" +
+	"\n" +
+	"LastPlayed\x18\x0f \x01(\tR\n" +
+	"LastPlayed\x12\x1e\n" +
+	"\n" +
+	"RunnerKind\x18\x10 \x01(\tR\n" +
+	"RunnerKind\x12\x1e\n" +
+	"\n" +
+	"RunnerPath\x18\x11 \x01(\tR\n" +
+	"RunnerPath\x12\x1e\n" +
+	"\n" +
+	"PrefixPath\x18\x12 \x01(\tR\n" +
+	"PrefixPath\":\n" +
 	"\vGetResponse\x12+\n" +
 	"\x02lg\x18\x01 \x01(\v2\x1b.frost_library.v1.LocalGameR\x02lg\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"\x10\n" +
-	"\x0eDeleteResponse\"Q\n" +
+	"\x0eDeleteResponse\"m\n" +
 	"\x0fDownloadRequest\x12\x16\n" +
 	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12&\n" +
-	"\x0edownloadFolder\x18\x02 \x01(\tR\x0edownloadFolder\"\x12\n" +
-	"\x10DownloadResponse\"\x18\n" +
+	"\x0edownloadFolder\x18\x02 \x01(\tR\x0edownloadFolder\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\"\x12\n" +
+	"\x10DownloadResponse\"&\n" +
+	"\fPauseRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x0f\n" +
+	"\rPauseResponse\"'\n" +
+	"\rResumeRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x10\n" +
+	"\x0eResumeResponse\"'\n" +
+	"\rCancelRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x10\n" +
+	"\x0eCancelResponse\"H\n" +
+	"\x12SetPriorityRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\"\x15\n" +
+	"\x13SetPriorityResponse\"\x12\n" +
+	"\x10ListQueueRequest\"\\\n" +
+	"\n" +
+	"QueueEntry\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\rR\bposition\"K\n" +
+	"\x11ListQueueResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.frost_library.v1.QueueEntryR\aentries\"\x18\n" +
 	"\x16ListDownloadingRequest\"R\n" +
 	"\fFileProgress\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1a\n" +
@@ -833,13 +3356,144 @@ const file_frost_library_v1_frost_library_proto_rawDesc = "" +
 	"\vTimeStarted\x18\x03 \x01(\tR\vTimeStarted\x12\"\n" +
 	"\fDownloadPath\x18\x04 \x01(\tR\fDownloadPath\"[\n" +
 	"\x17ListDownloadingResponse\x12@\n" +
-	"\tdownloads\x18\x01 \x03(\v2\".frost_library.v1.DownloadProgressR\tdownloads2\xc1\x03\n" +
+	"\tdownloads\x18\x01 \x03(\v2\".frost_library.v1.DownloadProgressR\tdownloads\"\x82\x01\n" +
+	"\tBandwidth\x12\x18\n" +
+	"\alimitKb\x18\x01 \x01(\x03R\alimitKb\x12 \n" +
+	"\vgameLimitKb\x18\x02 \x01(\x03R\vgameLimitKb\x129\n" +
+	"\bschedule\x18\x03 \x03(\v2\x1d.frost_library.v1.SpeedWindowR\bschedule\"O\n" +
+	"\vSpeedWindow\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\x12\x18\n" +
+	"\alimitKb\x18\x03 \x01(\x03R\alimitKb\"\x15\n" +
+	"\x13GetBandwidthRequest\"y\n" +
+	"\x14GetBandwidthResponse\x129\n" +
+	"\tbandwidth\x18\x01 \x01(\v2\x1b.frost_library.v1.BandwidthR\tbandwidth\x12&\n" +
+	"\x0ecurrentLimitKb\x18\x02 \x01(\x03R\x0ecurrentLimitKb\"P\n" +
+	"\x13SetBandwidthRequest\x129\n" +
+	"\tbandwidth\x18\x01 \x01(\v2\x1b.frost_library.v1.BandwidthR\tbandwidth\"\x16\n" +
+	"\x14SetBandwidthResponse\"0\n" +
+	"\x14WatchProgressRequest\x12\x18\n" +
+	"\agameIds\x18\x01 \x03(\x03R\agameIds\"\xb5\x02\n" +
+	"\x15WatchProgressResponse\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12$\n" +
+	"\rstatusMessage\x18\x03 \x01(\tR\rstatusMessage\x12\x12\n" +
+	"\x04file\x18\x04 \x01(\tR\x04file\x12\"\n" +
+	"\ffileComplete\x18\x05 \x01(\x03R\ffileComplete\x12\x1a\n" +
+	"\bfileSize\x18\x06 \x01(\x03R\bfileSize\x12\x1a\n" +
+	"\bcomplete\x18\a \x01(\x03R\bcomplete\x12\x14\n" +
+	"\x05total\x18\b \x01(\x03R\x05total\x12 \n" +
+	"\vbytesPerSec\x18\t \x01(\x03R\vbytesPerSec\x12\x1e\n" +
+	"\n" +
+	"etaSeconds\x18\n" +
+	" \x01(\x03R\n" +
+	"etaSeconds\"/\n" +
+	"\x15ListInstallersRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"3\n" +
+	"\tInstaller\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"U\n" +
+	"\x16ListInstallersResponse\x12;\n" +
+	"\n" +
+	"installers\x18\x01 \x03(\v2\x1b.frost_library.v1.InstallerR\n" +
+	"installers\"n\n" +
+	"\x0eInstallRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12$\n" +
+	"\rinstallerPath\x18\x02 \x01(\tR\rinstallerPath\x12\x1e\n" +
+	"\n" +
+	"installDir\x18\x03 \x01(\tR\n" +
+	"installDir\"\x11\n" +
+	"\x0fInstallResponse\".\n" +
+	"\x14CancelInstallRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x17\n" +
+	"\x15CancelInstallResponse\".\n" +
+	"\x14GetInstallLogRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\")\n" +
+	"\x15GetInstallLogResponse\x12\x10\n" +
+	"\x03log\x18\x01 \x01(\tR\x03log\"\x8b\x01\n" +
+	"\x17SetLaunchOptionsRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12\x18\n" +
+	"\aexePath\x18\x02 \x01(\tR\aexePath\x12\x12\n" +
+	"\x04args\x18\x03 \x03(\tR\x04args\x12\x10\n" +
+	"\x03env\x18\x04 \x03(\tR\x03env\x12\x18\n" +
+	"\aworkDir\x18\x05 \x01(\tR\aworkDir\"\x1a\n" +
+	"\x18SetLaunchOptionsResponse\"'\n" +
+	"\rLaunchRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x10\n" +
+	"\x0eLaunchResponse\")\n" +
+	"\x0fStopGameRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x12\n" +
+	"\x10StopGameResponse\"\x14\n" +
+	"\x12ListRunningRequest\"/\n" +
+	"\x13ListRunningResponse\x12\x18\n" +
+	"\agameIds\x18\x01 \x03(\x03R\agameIds\"C\n" +
+	"\x13ListSessionsRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"s\n" +
+	"\vPlaySession\x12\x18\n" +
+	"\astarted\x18\x01 \x01(\tR\astarted\x12\x14\n" +
+	"\x05ended\x18\x02 \x01(\tR\x05ended\x12\x18\n" +
+	"\aseconds\x18\x03 \x01(\x03R\aseconds\x12\x1a\n" +
+	"\bexitCode\x18\x04 \x01(\x05R\bexitCode\"u\n" +
+	"\x14ListSessionsResponse\x129\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1d.frost_library.v1.PlaySessionR\bsessions\x12\"\n" +
+	"\ftotalSeconds\x18\x02 \x01(\x03R\ftotalSeconds\"^\n" +
+	"\x06Runner\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\"\x14\n" +
+	"\x12ListRunnersRequest\"I\n" +
+	"\x13ListRunnersResponse\x122\n" +
+	"\arunners\x18\x01 \x03(\v2\x18.frost_library.v1.RunnerR\arunners\"r\n" +
+	"\x10SetRunnerRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1e\n" +
+	"\n" +
+	"prefixPath\x18\x04 \x01(\tR\n" +
+	"prefixPath\"\x13\n" +
+	"\x11SetRunnerResponse\"v\n" +
+	"\fVerifyReport\x12\x18\n" +
+	"\amissing\x18\x01 \x03(\tR\amissing\x12\x1a\n" +
+	"\bmodified\x18\x02 \x03(\tR\bmodified\x12\x14\n" +
+	"\x05extra\x18\x03 \x03(\tR\x05extra\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\x03R\bverified\"'\n" +
+	"\rVerifyRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"H\n" +
+	"\x0eVerifyResponse\x126\n" +
+	"\x06report\x18\x01 \x01(\v2\x1e.frost_library.v1.VerifyReportR\x06report\"'\n" +
+	"\rRepairRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"H\n" +
+	"\x0eRepairResponse\x126\n" +
+	"\x06report\x18\x01 \x01(\v2\x1e.frost_library.v1.VerifyReportR\x06report2\xae\x12\n" +
 	"\x13FrostLibraryService\x12D\n" +
 	"\x03Get\x12\x1c.frost_library.v1.GetRequest\x1a\x1d.frost_library.v1.GetResponse\"\x00\x12M\n" +
 	"\x06Delete\x12\x1f.frost_library.v1.DeleteRequest\x1a .frost_library.v1.DeleteResponse\"\x00\x12V\n" +
 	"\tListFiles\x12\".frost_library.v1.ListFilesRequest\x1a#.frost_library.v1.ListFilesResponse\"\x00\x12h\n" +
 	"\x0fListDownloading\x12(.frost_library.v1.ListDownloadingRequest\x1a).frost_library.v1.ListDownloadingResponse\"\x00\x12S\n" +
-	"\bDownload\x12!.frost_library.v1.DownloadRequest\x1a\".frost_library.v1.DownloadResponse\"\x00B\xbb\x01\n" +
+	"\bDownload\x12!.frost_library.v1.DownloadRequest\x1a\".frost_library.v1.DownloadResponse\"\x00\x12J\n" +
+	"\x05Pause\x12\x1e.frost_library.v1.PauseRequest\x1a\x1f.frost_library.v1.PauseResponse\"\x00\x12M\n" +
+	"\x06Resume\x12\x1f.frost_library.v1.ResumeRequest\x1a .frost_library.v1.ResumeResponse\"\x00\x12M\n" +
+	"\x06Cancel\x12\x1f.frost_library.v1.CancelRequest\x1a .frost_library.v1.CancelResponse\"\x00\x12\\\n" +
+	"\vSetPriority\x12$.frost_library.v1.SetPriorityRequest\x1a%.frost_library.v1.SetPriorityResponse\"\x00\x12V\n" +
+	"\tListQueue\x12\".frost_library.v1.ListQueueRequest\x1a#.frost_library.v1.ListQueueResponse\"\x00\x12d\n" +
+	"\rWatchProgress\x12&.frost_library.v1.WatchProgressRequest\x1a'.frost_library.v1.WatchProgressResponse\"\x000\x01\x12M\n" +
+	"\x06Verify\x12\x1f.frost_library.v1.VerifyRequest\x1a .frost_library.v1.VerifyResponse\"\x00\x12M\n" +
+	"\x06Repair\x12\x1f.frost_library.v1.RepairRequest\x1a .frost_library.v1.RepairResponse\"\x00\x12e\n" +
+	"\x0eListInstallers\x12'.frost_library.v1.ListInstallersRequest\x1a(.frost_library.v1.ListInstallersResponse\"\x00\x12P\n" +
+	"\aInstall\x12 .frost_library.v1.InstallRequest\x1a!.frost_library.v1.InstallResponse\"\x00\x12b\n" +
+	"\rCancelInstall\x12&.frost_library.v1.CancelInstallRequest\x1a'.frost_library.v1.CancelInstallResponse\"\x00\x12b\n" +
+	"\rGetInstallLog\x12&.frost_library.v1.GetInstallLogRequest\x1a'.frost_library.v1.GetInstallLogResponse\"\x00\x12k\n" +
+	"\x10SetLaunchOptions\x12).frost_library.v1.SetLaunchOptionsRequest\x1a*.frost_library.v1.SetLaunchOptionsResponse\"\x00\x12M\n" +
+	"\x06Launch\x12\x1f.frost_library.v1.LaunchRequest\x1a .frost_library.v1.LaunchResponse\"\x00\x12S\n" +
+	"\bStopGame\x12!.frost_library.v1.StopGameRequest\x1a\".frost_library.v1.StopGameResponse\"\x00\x12\\\n" +
+	"\vListRunning\x12$.frost_library.v1.ListRunningRequest\x1a%.frost_library.v1.ListRunningResponse\"\x00\x12_\n" +
+	"\fListSessions\x12%.frost_library.v1.ListSessionsRequest\x1a&.frost_library.v1.ListSessionsResponse\"\x00\x12\\\n" +
+	"\vListRunners\x12$.frost_library.v1.ListRunnersRequest\x1a%.frost_library.v1.ListRunnersResponse\"\x00\x12V\n" +
+	"\tSetRunner\x12\".frost_library.v1.SetRunnerRequest\x1a#.frost_library.v1.SetRunnerResponse\"\x00\x12_\n" +
+	"\fGetBandwidth\x12%.frost_library.v1.GetBandwidthRequest\x1a&.frost_library.v1.GetBandwidthResponse\"\x00\x12_\n" +
+	"\fSetBandwidth\x12%.frost_library.v1.SetBandwidthRequest\x1a&.frost_library.v1.SetBandwidthResponse\"\x00B\xbb\x01\n" +
 	"\x14com.frost_library.v1B\x11FrostLibraryProtoP\x01Z3github.com/ra341/glacier/generated/frost_library/v1\xa2\x02\x03FXX\xaa\x02\x0fFrostLibrary.V1\xca\x02\x0fFrostLibrary\\V1\xe2\x02\x1bFrostLibrary\\V1\\GPBMetadata\xea\x02\x10FrostLibrary::V1b\x06proto3"
 
 var (
@@ -854,45 +3508,145 @@ func file_frost_library_v1_frost_library_proto_rawDescGZIP() []byte {
 	return file_frost_library_v1_frost_library_proto_rawDescData
 }
 
-var file_frost_library_v1_frost_library_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_frost_library_v1_frost_library_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_frost_library_v1_frost_library_proto_goTypes = []any{
-	(*ListFilesRequest)(nil),        // 0: frost_library.v1.ListFilesRequest
-	(*ListFilesResponse)(nil),       // 1: frost_library.v1.ListFilesResponse
-	(*GetRequest)(nil),              // 2: frost_library.v1.GetRequest
-	(*LocalGame)(nil),               // 3: frost_library.v1.LocalGame
-	(*GetResponse)(nil),             // 4: frost_library.v1.GetResponse
-	(*DeleteRequest)(nil),           // 5: frost_library.v1.DeleteRequest
-	(*DeleteResponse)(nil),          // 6: frost_library.v1.DeleteResponse
-	(*DownloadRequest)(nil),         // 7: frost_library.v1.DownloadRequest
-	(*DownloadResponse)(nil),        // 8: frost_library.v1.DownloadResponse
-	(*ListDownloadingRequest)(nil),  // 9: frost_library.v1.ListDownloadingRequest
-	(*FileProgress)(nil),            // 10: frost_library.v1.FileProgress
-	(*FolderProgress)(nil),          // 11: frost_library.v1.FolderProgress
-	(*DownloadProgress)(nil),        // 12: frost_library.v1.DownloadProgress
-	(*DownloadInf)(nil),             // 13: frost_library.v1.DownloadInf
-	(*ListDownloadingResponse)(nil), // 14: frost_library.v1.ListDownloadingResponse
+	(*ListFilesRequest)(nil),         // 0: frost_library.v1.ListFilesRequest
+	(*ListFilesResponse)(nil),        // 1: frost_library.v1.ListFilesResponse
+	(*GetRequest)(nil),               // 2: frost_library.v1.GetRequest
+	(*LocalGame)(nil),                // 3: frost_library.v1.LocalGame
+	(*GetResponse)(nil),              // 4: frost_library.v1.GetResponse
+	(*DeleteRequest)(nil),            // 5: frost_library.v1.DeleteRequest
+	(*DeleteResponse)(nil),           // 6: frost_library.v1.DeleteResponse
+	(*DownloadRequest)(nil),          // 7: frost_library.v1.DownloadRequest
+	(*DownloadResponse)(nil),         // 8: frost_library.v1.DownloadResponse
+	(*PauseRequest)(nil),             // 9: frost_library.v1.PauseRequest
+	(*PauseResponse)(nil),            // 10: frost_library.v1.PauseResponse
+	(*ResumeRequest)(nil),            // 11: frost_library.v1.ResumeRequest
+	(*ResumeResponse)(nil),           // 12: frost_library.v1.ResumeResponse
+	(*CancelRequest)(nil),            // 13: frost_library.v1.CancelRequest
+	(*CancelResponse)(nil),           // 14: frost_library.v1.CancelResponse
+	(*SetPriorityRequest)(nil),       // 15: frost_library.v1.SetPriorityRequest
+	(*SetPriorityResponse)(nil),      // 16: frost_library.v1.SetPriorityResponse
+	(*ListQueueRequest)(nil),         // 17: frost_library.v1.ListQueueRequest
+	(*QueueEntry)(nil),               // 18: frost_library.v1.QueueEntry
+	(*ListQueueResponse)(nil),        // 19: frost_library.v1.ListQueueResponse
+	(*ListDownloadingRequest)(nil),   // 20: frost_library.v1.ListDownloadingRequest
+	(*FileProgress)(nil),             // 21: frost_library.v1.FileProgress
+	(*FolderProgress)(nil),           // 22: frost_library.v1.FolderProgress
+	(*DownloadProgress)(nil),         // 23: frost_library.v1.DownloadProgress
+	(*DownloadInf)(nil),              // 24: frost_library.v1.DownloadInf
+	(*ListDownloadingResponse)(nil),  // 25: frost_library.v1.ListDownloadingResponse
+	(*Bandwidth)(nil),                // 26: frost_library.v1.Bandwidth
+	(*SpeedWindow)(nil),              // 27: frost_library.v1.SpeedWindow
+	(*GetBandwidthRequest)(nil),      // 28: frost_library.v1.GetBandwidthRequest
+	(*GetBandwidthResponse)(nil),     // 29: frost_library.v1.GetBandwidthResponse
+	(*SetBandwidthRequest)(nil),      // 30: frost_library.v1.SetBandwidthRequest
+	(*SetBandwidthResponse)(nil),     // 31: frost_library.v1.SetBandwidthResponse
+	(*WatchProgressRequest)(nil),     // 32: frost_library.v1.WatchProgressRequest
+	(*WatchProgressResponse)(nil),    // 33: frost_library.v1.WatchProgressResponse
+	(*ListInstallersRequest)(nil),    // 34: frost_library.v1.ListInstallersRequest
+	(*Installer)(nil),                // 35: frost_library.v1.Installer
+	(*ListInstallersResponse)(nil),   // 36: frost_library.v1.ListInstallersResponse
+	(*InstallRequest)(nil),           // 37: frost_library.v1.InstallRequest
+	(*InstallResponse)(nil),          // 38: frost_library.v1.InstallResponse
+	(*CancelInstallRequest)(nil),     // 39: frost_library.v1.CancelInstallRequest
+	(*CancelInstallResponse)(nil),    // 40: frost_library.v1.CancelInstallResponse
+	(*GetInstallLogRequest)(nil),     // 41: frost_library.v1.GetInstallLogRequest
+	(*GetInstallLogResponse)(nil),    // 42: frost_library.v1.GetInstallLogResponse
+	(*SetLaunchOptionsRequest)(nil),  // 43: frost_library.v1.SetLaunchOptionsRequest
+	(*SetLaunchOptionsResponse)(nil), // 44: frost_library.v1.SetLaunchOptionsResponse
+	(*LaunchRequest)(nil),            // 45: frost_library.v1.LaunchRequest
+	(*LaunchResponse)(nil),           // 46: frost_library.v1.LaunchResponse
+	(*StopGameRequest)(nil),          // 47: frost_library.v1.StopGameRequest
+	(*StopGameResponse)(nil),         // 48: frost_library.v1.StopGameResponse
+	(*ListRunningRequest)(nil),       // 49: frost_library.v1.ListRunningRequest
+	(*ListRunningResponse)(nil),      // 50: frost_library.v1.ListRunningResponse
+	(*ListSessionsRequest)(nil),      // 51: frost_library.v1.ListSessionsRequest
+	(*PlaySession)(nil),              // 52: frost_library.v1.PlaySession
+	(*ListSessionsResponse)(nil),     // 53: frost_library.v1.ListSessionsResponse
+	(*Runner)(nil),                   // 54: frost_library.v1.Runner
+	(*ListRunnersRequest)(nil),       // 55: frost_library.v1.ListRunnersRequest
+	(*ListRunnersResponse)(nil),      // 56: frost_library.v1.ListRunnersResponse
+	(*SetRunnerRequest)(nil),         // 57: frost_library.v1.SetRunnerRequest
+	(*SetRunnerResponse)(nil),        // 58: frost_library.v1.SetRunnerResponse
+	(*VerifyReport)(nil),             // 59: frost_library.v1.VerifyReport
+	(*VerifyRequest)(nil),            // 60: frost_library.v1.VerifyRequest
+	(*VerifyResponse)(nil),           // 61: frost_library.v1.VerifyResponse
+	(*RepairRequest)(nil),            // 62: frost_library.v1.RepairRequest
+	(*RepairResponse)(nil),           // 63: frost_library.v1.RepairResponse
 }
 var file_frost_library_v1_frost_library_proto_depIdxs = []int32{
 	3,  // 0: frost_library.v1.GetResponse.lg:type_name -> frost_library.v1.LocalGame
-	10, // 1: frost_library.v1.FolderProgress.files:type_name -> frost_library.v1.FileProgress
-	13, // 2: frost_library.v1.DownloadProgress.download:type_name -> frost_library.v1.DownloadInf
-	11, // 3: frost_library.v1.DownloadProgress.progress:type_name -> frost_library.v1.FolderProgress
-	12, // 4: frost_library.v1.ListDownloadingResponse.downloads:type_name -> frost_library.v1.DownloadProgress
-	2,  // 5: frost_library.v1.FrostLibraryService.Get:input_type -> frost_library.v1.GetRequest
-	5,  // 6: frost_library.v1.FrostLibraryService.Delete:input_type -> frost_library.v1.DeleteRequest
-	0,  // 7: frost_library.v1.FrostLibraryService.ListFiles:input_type -> frost_library.v1.ListFilesRequest
-	9,  // 8: frost_library.v1.FrostLibraryService.ListDownloading:input_type -> frost_library.v1.ListDownloadingRequest
-	7,  // 9: frost_library.v1.FrostLibraryService.Download:input_type -> frost_library.v1.DownloadRequest
-	4,  // 10: frost_library.v1.FrostLibraryService.Get:output_type -> frost_library.v1.GetResponse
-	6,  // 11: frost_library.v1.FrostLibraryService.Delete:output_type -> frost_library.v1.DeleteResponse
-	1,  // 12: frost_library.v1.FrostLibraryService.ListFiles:output_type -> frost_library.v1.ListFilesResponse
-	14, // 13: frost_library.v1.FrostLibraryService.ListDownloading:output_type -> frost_library.v1.ListDownloadingResponse
-	8,  // 14: frost_library.v1.FrostLibraryService.Download:output_type -> frost_library.v1.DownloadResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	18, // 1: frost_library.v1.ListQueueResponse.entries:type_name -> frost_library.v1.QueueEntry
+	21, // 2: frost_library.v1.FolderProgress.files:type_name -> frost_library.v1.FileProgress
+	24, // 3: frost_library.v1.DownloadProgress.download:type_name -> frost_library.v1.DownloadInf
+	22, // 4: frost_library.v1.DownloadProgress.progress:type_name -> frost_library.v1.FolderProgress
+	23, // 5: frost_library.v1.ListDownloadingResponse.downloads:type_name -> frost_library.v1.DownloadProgress
+	27, // 6: frost_library.v1.Bandwidth.schedule:type_name -> frost_library.v1.SpeedWindow
+	26, // 7: frost_library.v1.GetBandwidthResponse.bandwidth:type_name -> frost_library.v1.Bandwidth
+	26, // 8: frost_library.v1.SetBandwidthRequest.bandwidth:type_name -> frost_library.v1.Bandwidth
+	35, // 9: frost_library.v1.ListInstallersResponse.installers:type_name -> frost_library.v1.Installer
+	52, // 10: frost_library.v1.ListSessionsResponse.sessions:type_name -> frost_library.v1.PlaySession
+	54, // 11: frost_library.v1.ListRunnersResponse.runners:type_name -> frost_library.v1.Runner
+	59, // 12: frost_library.v1.VerifyResponse.report:type_name -> frost_library.v1.VerifyReport
+	59, // 13: frost_library.v1.RepairResponse.report:type_name -> frost_library.v1.VerifyReport
+	2,  // 14: frost_library.v1.FrostLibraryService.Get:input_type -> frost_library.v1.GetRequest
+	5,  // 15: frost_library.v1.FrostLibraryService.Delete:input_type -> frost_library.v1.DeleteRequest
+	0,  // 16: frost_library.v1.FrostLibraryService.ListFiles:input_type -> frost_library.v1.ListFilesRequest
+	20, // 17: frost_library.v1.FrostLibraryService.ListDownloading:input_type -> frost_library.v1.ListDownloadingRequest
+	7,  // 18: frost_library.v1.FrostLibraryService.Download:input_type -> frost_library.v1.DownloadRequest
+	9,  // 19: frost_library.v1.FrostLibraryService.Pause:input_type -> frost_library.v1.PauseRequest
+	11, // 20: frost_library.v1.FrostLibraryService.Resume:input_type -> frost_library.v1.ResumeRequest
+	13, // 21: frost_library.v1.FrostLibraryService.Cancel:input_type -> frost_library.v1.CancelRequest
+	15, // 22: frost_library.v1.FrostLibraryService.SetPriority:input_type -> frost_library.v1.SetPriorityRequest
+	17, // 23: frost_library.v1.FrostLibraryService.ListQueue:input_type -> frost_library.v1.ListQueueRequest
+	32, // 24: frost_library.v1.FrostLibraryService.WatchProgress:input_type -> frost_library.v1.WatchProgressRequest
+	60, // 25: frost_library.v1.FrostLibraryService.Verify:input_type -> frost_library.v1.VerifyRequest
+	62, // 26: frost_library.v1.FrostLibraryService.Repair:input_type -> frost_library.v1.RepairRequest
+	34, // 27: frost_library.v1.FrostLibraryService.ListInstallers:input_type -> frost_library.v1.ListInstallersRequest
+	37, // 28: frost_library.v1.FrostLibraryService.Install:input_type -> frost_library.v1.InstallRequest
+	39, // 29: frost_library.v1.FrostLibraryService.CancelInstall:input_type -> frost_library.v1.CancelInstallRequest
+	41, // 30: frost_library.v1.FrostLibraryService.GetInstallLog:input_type -> frost_library.v1.GetInstallLogRequest
+	43, // 31: frost_library.v1.FrostLibraryService.SetLaunchOptions:input_type -> frost_library.v1.SetLaunchOptionsRequest
+	45, // 32: frost_library.v1.FrostLibraryService.Launch:input_type -> frost_library.v1.LaunchRequest
+	47, // 33: frost_library.v1.FrostLibraryService.StopGame:input_type -> frost_library.v1.StopGameRequest
+	49, // 34: frost_library.v1.FrostLibraryService.ListRunning:input_type -> frost_library.v1.ListRunningRequest
+	51, // 35: frost_library.v1.FrostLibraryService.ListSessions:input_type -> frost_library.v1.ListSessionsRequest
+	55, // 36: frost_library.v1.FrostLibraryService.ListRunners:input_type -> frost_library.v1.ListRunnersRequest
+	57, // 37: frost_library.v1.FrostLibraryService.SetRunner:input_type -> frost_library.v1.SetRunnerRequest
+	28, // 38: frost_library.v1.FrostLibraryService.GetBandwidth:input_type -> frost_library.v1.GetBandwidthRequest
+	30, // 39: frost_library.v1.FrostLibraryService.SetBandwidth:input_type -> frost_library.v1.SetBandwidthRequest
+	4,  // 40: frost_library.v1.FrostLibraryService.Get:output_type -> frost_library.v1.GetResponse
+	6,  // 41: frost_library.v1.FrostLibraryService.Delete:output_type -> frost_library.v1.DeleteResponse
+	1,  // 42: frost_library.v1.FrostLibraryService.ListFiles:output_type -> frost_library.v1.ListFilesResponse
+	25, // 43: frost_library.v1.FrostLibraryService.ListDownloading:output_type -> frost_library.v1.ListDownloadingResponse
+	8,  // 44: frost_library.v1.FrostLibraryService.Download:output_type -> frost_library.v1.DownloadResponse
+	10, // 45: frost_library.v1.FrostLibraryService.Pause:output_type -> frost_library.v1.PauseResponse
+	12, // 46: frost_library.v1.FrostLibraryService.Resume:output_type -> frost_library.v1.ResumeResponse
+	14, // 47: frost_library.v1.FrostLibraryService.Cancel:output_type -> frost_library.v1.CancelResponse
+	16, // 48: frost_library.v1.FrostLibraryService.SetPriority:output_type -> frost_library.v1.SetPriorityResponse
+	19, // 49: frost_library.v1.FrostLibraryService.ListQueue:output_type -> frost_library.v1.ListQueueResponse
+	33, // 50: frost_library.v1.FrostLibraryService.WatchProgress:output_type -> frost_library.v1.WatchProgressResponse
+	61, // 51: frost_library.v1.FrostLibraryService.Verify:output_type -> frost_library.v1.VerifyResponse
+	63, // 52: frost_library.v1.FrostLibraryService.Repair:output_type -> frost_library.v1.RepairResponse
+	36, // 53: frost_library.v1.FrostLibraryService.ListInstallers:output_type -> frost_library.v1.ListInstallersResponse
+	38, // 54: frost_library.v1.FrostLibraryService.Install:output_type -> frost_library.v1.InstallResponse
+	40, // 55: frost_library.v1.FrostLibraryService.CancelInstall:output_type -> frost_library.v1.CancelInstallResponse
+	42, // 56: frost_library.v1.FrostLibraryService.GetInstallLog:output_type -> frost_library.v1.GetInstallLogResponse
+	44, // 57: frost_library.v1.FrostLibraryService.SetLaunchOptions:output_type -> frost_library.v1.SetLaunchOptionsResponse
+	46, // 58: frost_library.v1.FrostLibraryService.Launch:output_type -> frost_library.v1.LaunchResponse
+	48, // 59: frost_library.v1.FrostLibraryService.StopGame:output_type -> frost_library.v1.StopGameResponse
+	50, // 60: frost_library.v1.FrostLibraryService.ListRunning:output_type -> frost_library.v1.ListRunningResponse
+	53, // 61: frost_library.v1.FrostLibraryService.ListSessions:output_type -> frost_library.v1.ListSessionsResponse
+	56, // 62: frost_library.v1.FrostLibraryService.ListRunners:output_type -> frost_library.v1.ListRunnersResponse
+	58, // 63: frost_library.v1.FrostLibraryService.SetRunner:output_type -> frost_library.v1.SetRunnerResponse
+	29, // 64: frost_library.v1.FrostLibraryService.GetBandwidth:output_type -> frost_library.v1.GetBandwidthResponse
+	31, // 65: frost_library.v1.FrostLibraryService.SetBandwidth:output_type -> frost_library.v1.SetBandwidthResponse
+	40, // [40:66] is the sub-list for method output_type
+	14, // [14:40] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_frost_library_v1_frost_library_proto_init() }
@@ -906,7 +3660,7 @@ func file_frost_library_v1_frost_library_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_frost_library_v1_frost_library_proto_rawDesc), len(file_frost_library_v1_frost_library_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -47,6 +47,69 @@ const (
 	// FrostLibraryServiceDownloadProcedure is the fully-qualified name of the FrostLibraryService's
 	// Download RPC.
 	FrostLibraryServiceDownloadProcedure = "/frost_library.v1.FrostLibraryService/Download"
+	// FrostLibraryServicePauseProcedure is the fully-qualified name of the FrostLibraryService's Pause
+	// RPC.
+	FrostLibraryServicePauseProcedure = "/frost_library.v1.FrostLibraryService/Pause"
+	// FrostLibraryServiceResumeProcedure is the fully-qualified name of the FrostLibraryService's
+	// Resume RPC.
+	FrostLibraryServiceResumeProcedure = "/frost_library.v1.FrostLibraryService/Resume"
+	// FrostLibraryServiceCancelProcedure is the fully-qualified name of the FrostLibraryService's
+	// Cancel RPC.
+	FrostLibraryServiceCancelProcedure = "/frost_library.v1.FrostLibraryService/Cancel"
+	// FrostLibraryServiceSetPriorityProcedure is the fully-qualified name of the FrostLibraryService's
+	// SetPriority RPC.
+	FrostLibraryServiceSetPriorityProcedure = "/frost_library.v1.FrostLibraryService/SetPriority"
+	// FrostLibraryServiceListQueueProcedure is the fully-qualified name of the FrostLibraryService's
+	// ListQueue RPC.
+	FrostLibraryServiceListQueueProcedure = "/frost_library.v1.FrostLibraryService/ListQueue"
+	// FrostLibraryServiceWatchProgressProcedure is the fully-qualified name of the
+	// FrostLibraryService's WatchProgress RPC.
+	FrostLibraryServiceWatchProgressProcedure = "/frost_library.v1.FrostLibraryService/WatchProgress"
+	// FrostLibraryServiceVerifyProcedure is the fully-qualified name of the FrostLibraryService's
+	// Verify RPC.
+	FrostLibraryServiceVerifyProcedure = "/frost_library.v1.FrostLibraryService/Verify"
+	// FrostLibraryServiceRepairProcedure is the fully-qualified name of the FrostLibraryService's
+	// Repair RPC.
+	FrostLibraryServiceRepairProcedure = "/frost_library.v1.FrostLibraryService/Repair"
+	// FrostLibraryServiceListInstallersProcedure is the fully-qualified name of the
+	// FrostLibraryService's ListInstallers RPC.
+	FrostLibraryServiceListInstallersProcedure = "/frost_library.v1.FrostLibraryService/ListInstallers"
+	// FrostLibraryServiceInstallProcedure is the fully-qualified name of the FrostLibraryService's
+	// Install RPC.
+	FrostLibraryServiceInstallProcedure = "/frost_library.v1.FrostLibraryService/Install"
+	// FrostLibraryServiceCancelInstallProcedure is the fully-qualified name of the
+	// FrostLibraryService's CancelInstall RPC.
+	FrostLibraryServiceCancelInstallProcedure = "/frost_library.v1.FrostLibraryService/CancelInstall"
+	// FrostLibraryServiceGetInstallLogProcedure is the fully-qualified name of the
+	// FrostLibraryService's GetInstallLog RPC.
+	FrostLibraryServiceGetInstallLogProcedure = "/frost_library.v1.FrostLibraryService/GetInstallLog"
+	// FrostLibraryServiceSetLaunchOptionsProcedure is the fully-qualified name of the
+	// FrostLibraryService's SetLaunchOptions RPC.
+	FrostLibraryServiceSetLaunchOptionsProcedure = "/frost_library.v1.FrostLibraryService/SetLaunchOptions"
+	// FrostLibraryServiceLaunchProcedure is the fully-qualified name of the FrostLibraryService's
+	// Launch RPC.
+	FrostLibraryServiceLaunchProcedure = "/frost_library.v1.FrostLibraryService/Launch"
+	// FrostLibraryServiceStopGameProcedure is the fully-qualified name of the FrostLibraryService's
+	// StopGame RPC.
+	FrostLibraryServiceStopGameProcedure = "/frost_library.v1.FrostLibraryService/StopGame"
+	// FrostLibraryServiceListRunningProcedure is the fully-qualified name of the FrostLibraryService's
+	// ListRunning RPC.
+	FrostLibraryServiceListRunningProcedure = "/frost_library.v1.FrostLibraryService/ListRunning"
+	// FrostLibraryServiceListSessionsProcedure is the fully-qualified name of the FrostLibraryService's
+	// ListSessions RPC.
+	FrostLibraryServiceListSessionsProcedure = "/frost_library.v1.FrostLibraryService/ListSessions"
+	// FrostLibraryServiceListRunnersProcedure is the fully-qualified name of the FrostLibraryService's
+	// ListRunners RPC.
+	FrostLibraryServiceListRunnersProcedure = "/frost_library.v1.FrostLibraryService/ListRunners"
+	// FrostLibraryServiceSetRunnerProcedure is the fully-qualified name of the FrostLibraryService's
+	// SetRunner RPC.
+	FrostLibraryServiceSetRunnerProcedure = "/frost_library.v1.FrostLibraryService/SetRunner"
+	// FrostLibraryServiceGetBandwidthProcedure is the fully-qualified name of the FrostLibraryService's
+	// GetBandwidth RPC.
+	FrostLibraryServiceGetBandwidthProcedure = "/frost_library.v1.FrostLibraryService/GetBandwidth"
+	// FrostLibraryServiceSetBandwidthProcedure is the fully-qualified name of the FrostLibraryService's
+	// SetBandwidth RPC.
+	FrostLibraryServiceSetBandwidthProcedure = "/frost_library.v1.FrostLibraryService/SetBandwidth"
 )
 
 // FrostLibraryServiceClient is a client for the frost_library.v1.FrostLibraryService service.
@@ -56,6 +119,34 @@ type FrostLibraryServiceClient interface {
 	ListFiles(context.Context, *connect.Request[v1.ListFilesRequest]) (*connect.Response[v1.ListFilesResponse], error)
 	ListDownloading(context.Context, *connect.Request[v1.ListDownloadingRequest]) (*connect.Response[v1.ListDownloadingResponse], error)
 	Download(context.Context, *connect.Request[v1.DownloadRequest]) (*connect.Response[v1.DownloadResponse], error)
+	Pause(context.Context, *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error)
+	Resume(context.Context, *connect.Request[v1.ResumeRequest]) (*connect.Response[v1.ResumeResponse], error)
+	Cancel(context.Context, *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error)
+	SetPriority(context.Context, *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error)
+	ListQueue(context.Context, *connect.Request[v1.ListQueueRequest]) (*connect.Response[v1.ListQueueResponse], error)
+	// streams progress as chunks finish and downloads change status
+	WatchProgress(context.Context, *connect.Request[v1.WatchProgressRequest]) (*connect.ServerStreamForClient[v1.WatchProgressResponse], error)
+	// hashes the downloaded files and compares them with the server manifest
+	Verify(context.Context, *connect.Request[v1.VerifyRequest]) (*connect.Response[v1.VerifyResponse], error)
+	// verifies the game and downloads its missing and modified files again
+	Repair(context.Context, *connect.Request[v1.RepairRequest]) (*connect.Response[v1.RepairResponse], error)
+	// installer candidates found in the downloaded files, the most likely first
+	ListInstallers(context.Context, *connect.Request[v1.ListInstallersRequest]) (*connect.Response[v1.ListInstallersResponse], error)
+	Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[v1.InstallResponse], error)
+	CancelInstall(context.Context, *connect.Request[v1.CancelInstallRequest]) (*connect.Response[v1.CancelInstallResponse], error)
+	GetInstallLog(context.Context, *connect.Request[v1.GetInstallLogRequest]) (*connect.Response[v1.GetInstallLogResponse], error)
+	SetLaunchOptions(context.Context, *connect.Request[v1.SetLaunchOptionsRequest]) (*connect.Response[v1.SetLaunchOptionsResponse], error)
+	Launch(context.Context, *connect.Request[v1.LaunchRequest]) (*connect.Response[v1.LaunchResponse], error)
+	StopGame(context.Context, *connect.Request[v1.StopGameRequest]) (*connect.Response[v1.StopGameResponse], error)
+	ListRunning(context.Context, *connect.Request[v1.ListRunningRequest]) (*connect.Response[v1.ListRunningResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// wine and proton installs found in the usual locations
+	ListRunners(context.Context, *connect.Request[v1.ListRunnersRequest]) (*connect.Response[v1.ListRunnersResponse], error)
+	// sets how windows installers and games run on linux
+	SetRunner(context.Context, *connect.Request[v1.SetRunnerRequest]) (*connect.Response[v1.SetRunnerResponse], error)
+	GetBandwidth(context.Context, *connect.Request[v1.GetBandwidthRequest]) (*connect.Response[v1.GetBandwidthResponse], error)
+	// changes are kept until frost restarts
+	SetBandwidth(context.Context, *connect.Request[v1.SetBandwidthRequest]) (*connect.Response[v1.SetBandwidthResponse], error)
 }
 
 // NewFrostLibraryServiceClient constructs a client for the frost_library.v1.FrostLibraryService
@@ -99,16 +190,163 @@ func NewFrostLibraryServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(frostLibraryServiceMethods.ByName("Download")),
 			connect.WithClientOptions(opts...),
 		),
+		pause: connect.NewClient[v1.PauseRequest, v1.PauseResponse](
+			httpClient,
+			baseURL+FrostLibraryServicePauseProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("Pause")),
+			connect.WithClientOptions(opts...),
+		),
+		resume: connect.NewClient[v1.ResumeRequest, v1.ResumeResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceResumeProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("Resume")),
+			connect.WithClientOptions(opts...),
+		),
+		cancel: connect.NewClient[v1.CancelRequest, v1.CancelResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceCancelProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("Cancel")),
+			connect.WithClientOptions(opts...),
+		),
+		setPriority: connect.NewClient[v1.SetPriorityRequest, v1.SetPriorityResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceSetPriorityProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("SetPriority")),
+			connect.WithClientOptions(opts...),
+		),
+		listQueue: connect.NewClient[v1.ListQueueRequest, v1.ListQueueResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceListQueueProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("ListQueue")),
+			connect.WithClientOptions(opts...),
+		),
+		watchProgress: connect.NewClient[v1.WatchProgressRequest, v1.WatchProgressResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceWatchProgressProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("WatchProgress")),
+			connect.WithClientOptions(opts...),
+		),
+		verify: connect.NewClient[v1.VerifyRequest, v1.VerifyResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceVerifyProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("Verify")),
+			connect.WithClientOptions(opts...),
+		),
+		repair: connect.NewClient[v1.RepairRequest, v1.RepairResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceRepairProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("Repair")),
+			connect.WithClientOptions(opts...),
+		),
+		listInstallers: connect.NewClient[v1.ListInstallersRequest, v1.ListInstallersResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceListInstallersProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("ListInstallers")),
+			connect.WithClientOptions(opts...),
+		),
+		install: connect.NewClient[v1.InstallRequest, v1.InstallResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceInstallProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("Install")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelInstall: connect.NewClient[v1.CancelInstallRequest, v1.CancelInstallResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceCancelInstallProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("CancelInstall")),
+			connect.WithClientOptions(opts...),
+		),
+		getInstallLog: connect.NewClient[v1.GetInstallLogRequest, v1.GetInstallLogResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceGetInstallLogProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("GetInstallLog")),
+			connect.WithClientOptions(opts...),
+		),
+		setLaunchOptions: connect.NewClient[v1.SetLaunchOptionsRequest, v1.SetLaunchOptionsResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceSetLaunchOptionsProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("SetLaunchOptions")),
+			connect.WithClientOptions(opts...),
+		),
+		launch: connect.NewClient[v1.LaunchRequest, v1.LaunchResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceLaunchProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("Launch")),
+			connect.WithClientOptions(opts...),
+		),
+		stopGame: connect.NewClient[v1.StopGameRequest, v1.StopGameResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceStopGameProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("StopGame")),
+			connect.WithClientOptions(opts...),
+		),
+		listRunning: connect.NewClient[v1.ListRunningRequest, v1.ListRunningResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceListRunningProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("ListRunning")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceListSessionsProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		listRunners: connect.NewClient[v1.ListRunnersRequest, v1.ListRunnersResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceListRunnersProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("ListRunners")),
+			connect.WithClientOptions(opts...),
+		),
+		setRunner: connect.NewClient[v1.SetRunnerRequest, v1.SetRunnerResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceSetRunnerProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("SetRunner")),
+			connect.WithClientOptions(opts...),
+		),
+		getBandwidth: connect.NewClient[v1.GetBandwidthRequest, v1.GetBandwidthResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceGetBandwidthProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("GetBandwidth")),
+			connect.WithClientOptions(opts...),
+		),
+		setBandwidth: connect.NewClient[v1.SetBandwidthRequest, v1.SetBandwidthResponse](
+			httpClient,
+			baseURL+FrostLibraryServiceSetBandwidthProcedure,
+			connect.WithSchema(frostLibraryServiceMethods.ByName("SetBandwidth")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // frostLibraryServiceClient implements FrostLibraryServiceClient.
 type frostLibraryServiceClient struct {
-	get             *connect.Client[v1.GetRequest, v1.GetResponse]
-	delete          *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
-	listFiles       *connect.Client[v1.ListFilesRequest, v1.ListFilesResponse]
-	listDownloading *connect.Client[v1.ListDownloadingRequest, v1.ListDownloadingResponse]
-	download        *connect.Client[v1.DownloadRequest, v1.DownloadResponse]
+	get              *connect.Client[v1.GetRequest, v1.GetResponse]
+	delete           *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
+	listFiles        *connect.Client[v1.ListFilesRequest, v1.ListFilesResponse]
+	listDownloading  *connect.Client[v1.ListDownloadingRequest, v1.ListDownloadingResponse]
+	download         *connect.Client[v1.DownloadRequest, v1.DownloadResponse]
+	pause            *connect.Client[v1.PauseRequest, v1.PauseResponse]
+	resume           *connect.Client[v1.ResumeRequest, v1.ResumeResponse]
+	cancel           *connect.Client[v1.CancelRequest, v1.CancelResponse]
+	setPriority      *connect.Client[v1.SetPriorityRequest, v1.SetPriorityResponse]
+	listQueue        *connect.Client[v1.ListQueueRequest, v1.ListQueueResponse]
+	watchProgress    *connect.Client[v1.WatchProgressRequest, v1.WatchProgressResponse]
+	verify           *connect.Client[v1.VerifyRequest, v1.VerifyResponse]
+	repair           *connect.Client[v1.RepairRequest, v1.RepairResponse]
+	listInstallers   *connect.Client[v1.ListInstallersRequest, v1.ListInstallersResponse]
+	install          *connect.Client[v1.InstallRequest, v1.InstallResponse]
+	cancelInstall    *connect.Client[v1.CancelInstallRequest, v1.CancelInstallResponse]
+	getInstallLog    *connect.Client[v1.GetInstallLogRequest, v1.GetInstallLogResponse]
+	setLaunchOptions *connect.Client[v1.SetLaunchOptionsRequest, v1.SetLaunchOptionsResponse]
+	launch           *connect.Client[v1.LaunchRequest, v1.LaunchResponse]
+	stopGame         *connect.Client[v1.StopGameRequest, v1.StopGameResponse]
+	listRunning      *connect.Client[v1.ListRunningRequest, v1.ListRunningResponse]
+	listSessions     *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	listRunners      *connect.Client[v1.ListRunnersRequest, v1.ListRunnersResponse]
+	setRunner        *connect.Client[v1.SetRunnerRequest, v1.SetRunnerResponse]
+	getBandwidth     *connect.Client[v1.GetBandwidthRequest, v1.GetBandwidthResponse]
+	setBandwidth     *connect.Client[v1.SetBandwidthRequest, v1.SetBandwidthResponse]
 }
 
 // Get calls frost_library.v1.FrostLibraryService.Get.
@@ -136,6 +374,111 @@ func (c *frostLibraryServiceClient) Download(ctx context.Context, req *connect.R
 	return c.download.CallUnary(ctx, req)
 }
 
+// Pause calls frost_library.v1.FrostLibraryService.Pause.
+func (c *frostLibraryServiceClient) Pause(ctx context.Context, req *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error) {
+	return c.pause.CallUnary(ctx, req)
+}
+
+// Resume calls frost_library.v1.FrostLibraryService.Resume.
+func (c *frostLibraryServiceClient) Resume(ctx context.Context, req *connect.Request[v1.ResumeRequest]) (*connect.Response[v1.ResumeResponse], error) {
+	return c.resume.CallUnary(ctx, req)
+}
+
+// Cancel calls frost_library.v1.FrostLibraryService.Cancel.
+func (c *frostLibraryServiceClient) Cancel(ctx context.Context, req *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error) {
+	return c.cancel.CallUnary(ctx, req)
+}
+
+// SetPriority calls frost_library.v1.FrostLibraryService.SetPriority.
+func (c *frostLibraryServiceClient) SetPriority(ctx context.Context, req *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error) {
+	return c.setPriority.CallUnary(ctx, req)
+}
+
+// ListQueue calls frost_library.v1.FrostLibraryService.ListQueue.
+func (c *frostLibraryServiceClient) ListQueue(ctx context.Context, req *connect.Request[v1.ListQueueRequest]) (*connect.Response[v1.ListQueueResponse], error) {
+	return c.listQueue.CallUnary(ctx, req)
+}
+
+// WatchProgress calls frost_library.v1.FrostLibraryService.WatchProgress.
+func (c *frostLibraryServiceClient) WatchProgress(ctx context.Context, req *connect.Request[v1.WatchProgressRequest]) (*connect.ServerStreamForClient[v1.WatchProgressResponse], error) {
+	return c.watchProgress.CallServerStream(ctx, req)
+}
+
+// Verify calls frost_library.v1.FrostLibraryService.Verify.
+func (c *frostLibraryServiceClient) Verify(ctx context.Context, req *connect.Request[v1.VerifyRequest]) (*connect.Response[v1.VerifyResponse], error) {
+	return c.verify.CallUnary(ctx, req)
+}
+
+// Repair calls frost_library.v1.FrostLibraryService.Repair.
+func (c *frostLibraryServiceClient) Repair(ctx context.Context, req *connect.Request[v1.RepairRequest]) (*connect.Response[v1.RepairResponse], error) {
+	return c.repair.CallUnary(ctx, req)
+}
+
+// ListInstallers calls frost_library.v1.FrostLibraryService.ListInstallers.
+func (c *frostLibraryServiceClient) ListInstallers(ctx context.Context, req *connect.Request[v1.ListInstallersRequest]) (*connect.Response[v1.ListInstallersResponse], error) {
+	return c.listInstallers.CallUnary(ctx, req)
+}
+
+// Install calls frost_library.v1.FrostLibraryService.Install.
+func (c *frostLibraryServiceClient) Install(ctx context.Context, req *connect.Request[v1.InstallRequest]) (*connect.Response[v1.InstallResponse], error) {
+	return c.install.CallUnary(ctx, req)
+}
+
+// CancelInstall calls frost_library.v1.FrostLibraryService.CancelInstall.
+func (c *frostLibraryServiceClient) CancelInstall(ctx context.Context, req *connect.Request[v1.CancelInstallRequest]) (*connect.Response[v1.CancelInstallResponse], error) {
+	return c.cancelInstall.CallUnary(ctx, req)
+}
+
+// GetInstallLog calls frost_library.v1.FrostLibraryService.GetInstallLog.
+func (c *frostLibraryServiceClient) GetInstallLog(ctx context.Context, req *connect.Request[v1.GetInstallLogRequest]) (*connect.Response[v1.GetInstallLogResponse], error) {
+	return c.getInstallLog.CallUnary(ctx, req)
+}
+
+// SetLaunchOptions calls frost_library.v1.FrostLibraryService.SetLaunchOptions.
+func (c *frostLibraryServiceClient) SetLaunchOptions(ctx context.Context, req *connect.Request[v1.SetLaunchOptionsRequest]) (*connect.Response[v1.SetLaunchOptionsResponse], error) {
+	return c.setLaunchOptions.CallUnary(ctx, req)
+}
+
+// Launch calls frost_library.v1.FrostLibraryService.Launch.
+func (c *frostLibraryServiceClient) Launch(ctx context.Context, req *connect.Request[v1.LaunchRequest]) (*connect.Response[v1.LaunchResponse], error) {
+	return c.launch.CallUnary(ctx, req)
+}
+
+// StopGame calls frost_library.v1.FrostLibraryService.StopGame.
+func (c *frostLibraryServiceClient) StopGame(ctx context.Context, req *connect.Request[v1.StopGameRequest]) (*connect.Response[v1.StopGameResponse], error) {
+	return c.stopGame.CallUnary(ctx, req)
+}
+
+// ListRunning calls frost_library.v1.FrostLibraryService.ListRunning.
+func (c *frostLibraryServiceClient) ListRunning(ctx context.Context, req *connect.Request[v1.ListRunningRequest]) (*connect.Response[v1.ListRunningResponse], error) {
+	return c.listRunning.CallUnary(ctx, req)
+}
+
+// ListSessions calls frost_library.v1.FrostLibraryService.ListSessions.
+func (c *frostLibraryServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// ListRunners calls frost_library.v1.FrostLibraryService.ListRunners.
+func (c *frostLibraryServiceClient) ListRunners(ctx context.Context, req *connect.Request[v1.ListRunnersRequest]) (*connect.Response[v1.ListRunnersResponse], error) {
+	return c.listRunners.CallUnary(ctx, req)
+}
+
+// SetRunner calls frost_library.v1.FrostLibraryService.SetRunner.
+func (c *frostLibraryServiceClient) SetRunner(ctx context.Context, req *connect.Request[v1.SetRunnerRequest]) (*connect.Response[v1.SetRunnerResponse], error) {
+	return c.setRunner.CallUnary(ctx, req)
+}
+
+// GetBandwidth calls frost_library.v1.FrostLibraryService.GetBandwidth.
+func (c *frostLibraryServiceClient) GetBandwidth(ctx context.Context, req *connect.Request[v1.GetBandwidthRequest]) (*connect.Response[v1.GetBandwidthResponse], error) {
+	return c.getBandwidth.CallUnary(ctx, req)
+}
+
+// SetBandwidth calls frost_library.v1.FrostLibraryService.SetBandwidth.
+func (c *frostLibraryServiceClient) SetBandwidth(ctx context.Context, req *connect.Request[v1.SetBandwidthRequest]) (*connect.Response[v1.SetBandwidthResponse], error) {
+	return c.setBandwidth.CallUnary(ctx, req)
+}
+
 // FrostLibraryServiceHandler is an implementation of the frost_library.v1.FrostLibraryService
 // service.
 type FrostLibraryServiceHandler interface {
@@ -144,6 +487,34 @@ type FrostLibraryServiceHandler interface {
 	ListFiles(context.Context, *connect.Request[v1.ListFilesRequest]) (*connect.Response[v1.ListFilesResponse], error)
 	ListDownloading(context.Context, *connect.Request[v1.ListDownloadingRequest]) (*connect.Response[v1.ListDownloadingResponse], error)
 	Download(context.Context, *connect.Request[v1.DownloadRequest]) (*connect.Response[v1.DownloadResponse], error)
+	Pause(context.Context, *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error)
+	Resume(context.Context, *connect.Request[v1.ResumeRequest]) (*connect.Response[v1.ResumeResponse], error)
+	Cancel(context.Context, *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error)
+	SetPriority(context.Context, *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error)
+	ListQueue(context.Context, *connect.Request[v1.ListQueueRequest]) (*connect.Response[v1.ListQueueResponse], error)
+	// streams progress as chunks finish and downloads change status
+	WatchProgress(context.Context, *connect.Request[v1.WatchProgressRequest], *connect.ServerStream[v1.WatchProgressResponse]) error
+	// hashes the downloaded files and compares them with the server manifest
+	Verify(context.Context, *connect.Request[v1.VerifyRequest]) (*connect.Response[v1.VerifyResponse], error)
+	// verifies the game and downloads its missing and modified files again
+	Repair(context.Context, *connect.Request[v1.RepairRequest]) (*connect.Response[v1.RepairResponse], error)
+	// installer candidates found in the downloaded files, the most likely first
+	ListInstallers(context.Context, *connect.Request[v1.ListInstallersRequest]) (*connect.Response[v1.ListInstallersResponse], error)
+	Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[v1.InstallResponse], error)
+	CancelInstall(context.Context, *connect.Request[v1.CancelInstallRequest]) (*connect.Response[v1.CancelInstallResponse], error)
+	GetInstallLog(context.Context, *connect.Request[v1.GetInstallLogRequest]) (*connect.Response[v1.GetInstallLogResponse], error)
+	SetLaunchOptions(context.Context, *connect.Request[v1.SetLaunchOptionsRequest]) (*connect.Response[v1.SetLaunchOptionsResponse], error)
+	Launch(context.Context, *connect.Request[v1.LaunchRequest]) (*connect.Response[v1.LaunchResponse], error)
+	StopGame(context.Context, *connect.Request[v1.StopGameRequest]) (*connect.Response[v1.StopGameResponse], error)
+	ListRunning(context.Context, *connect.Request[v1.ListRunningRequest]) (*connect.Response[v1.ListRunningResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// wine and proton installs found in the usual locations
+	ListRunners(context.Context, *connect.Request[v1.ListRunnersRequest]) (*connect.Response[v1.ListRunnersResponse], error)
+	// sets how windows installers and games run on linux
+	SetRunner(context.Context, *connect.Request[v1.SetRunnerRequest]) (*connect.Response[v1.SetRunnerResponse], error)
+	GetBandwidth(context.Context, *connect.Request[v1.GetBandwidthRequest]) (*connect.Response[v1.GetBandwidthResponse], error)
+	// changes are kept until frost restarts
+	SetBandwidth(context.Context, *connect.Request[v1.SetBandwidthRequest]) (*connect.Response[v1.SetBandwidthResponse], error)
 }
 
 // NewFrostLibraryServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -183,6 +554,132 @@ func NewFrostLibraryServiceHandler(svc FrostLibraryServiceHandler, opts ...conne
 		connect.WithSchema(frostLibraryServiceMethods.ByName("Download")),
 		connect.WithHandlerOptions(opts...),
 	)
+	frostLibraryServicePauseHandler := connect.NewUnaryHandler(
+		FrostLibraryServicePauseProcedure,
+		svc.Pause,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("Pause")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceResumeHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceResumeProcedure,
+		svc.Resume,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("Resume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceCancelHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceCancelProcedure,
+		svc.Cancel,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("Cancel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceSetPriorityHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceSetPriorityProcedure,
+		svc.SetPriority,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("SetPriority")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceListQueueHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceListQueueProcedure,
+		svc.ListQueue,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("ListQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceWatchProgressHandler := connect.NewServerStreamHandler(
+		FrostLibraryServiceWatchProgressProcedure,
+		svc.WatchProgress,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("WatchProgress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceVerifyHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceVerifyProcedure,
+		svc.Verify,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("Verify")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceRepairHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceRepairProcedure,
+		svc.Repair,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("Repair")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceListInstallersHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceListInstallersProcedure,
+		svc.ListInstallers,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("ListInstallers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceInstallHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceInstallProcedure,
+		svc.Install,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("Install")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceCancelInstallHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceCancelInstallProcedure,
+		svc.CancelInstall,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("CancelInstall")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceGetInstallLogHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceGetInstallLogProcedure,
+		svc.GetInstallLog,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("GetInstallLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceSetLaunchOptionsHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceSetLaunchOptionsProcedure,
+		svc.SetLaunchOptions,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("SetLaunchOptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceLaunchHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceLaunchProcedure,
+		svc.Launch,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("Launch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceStopGameHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceStopGameProcedure,
+		svc.StopGame,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("StopGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceListRunningHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceListRunningProcedure,
+		svc.ListRunning,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("ListRunning")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceListSessionsHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceListRunnersHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceListRunnersProcedure,
+		svc.ListRunners,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("ListRunners")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceSetRunnerHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceSetRunnerProcedure,
+		svc.SetRunner,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("SetRunner")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceGetBandwidthHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceGetBandwidthProcedure,
+		svc.GetBandwidth,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("GetBandwidth")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frostLibraryServiceSetBandwidthHandler := connect.NewUnaryHandler(
+		FrostLibraryServiceSetBandwidthProcedure,
+		svc.SetBandwidth,
+		connect.WithSchema(frostLibraryServiceMethods.ByName("SetBandwidth")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/frost_library.v1.FrostLibraryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FrostLibraryServiceGetProcedure:
@@ -195,6 +692,48 @@ func NewFrostLibraryServiceHandler(svc FrostLibraryServiceHandler, opts ...conne
 			frostLibraryServiceListDownloadingHandler.ServeHTTP(w, r)
 		case FrostLibraryServiceDownloadProcedure:
 			frostLibraryServiceDownloadHandler.ServeHTTP(w, r)
+		case FrostLibraryServicePauseProcedure:
+			frostLibraryServicePauseHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceResumeProcedure:
+			frostLibraryServiceResumeHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceCancelProcedure:
+			frostLibraryServiceCancelHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceSetPriorityProcedure:
+			frostLibraryServiceSetPriorityHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceListQueueProcedure:
+			frostLibraryServiceListQueueHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceWatchProgressProcedure:
+			frostLibraryServiceWatchProgressHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceVerifyProcedure:
+			frostLibraryServiceVerifyHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceRepairProcedure:
+			frostLibraryServiceRepairHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceListInstallersProcedure:
+			frostLibraryServiceListInstallersHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceInstallProcedure:
+			frostLibraryServiceInstallHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceCancelInstallProcedure:
+			frostLibraryServiceCancelInstallHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceGetInstallLogProcedure:
+			frostLibraryServiceGetInstallLogHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceSetLaunchOptionsProcedure:
+			frostLibraryServiceSetLaunchOptionsHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceLaunchProcedure:
+			frostLibraryServiceLaunchHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceStopGameProcedure:
+			frostLibraryServiceStopGameHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceListRunningProcedure:
+			frostLibraryServiceListRunningHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceListSessionsProcedure:
+			frostLibraryServiceListSessionsHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceListRunnersProcedure:
+			frostLibraryServiceListRunnersHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceSetRunnerProcedure:
+			frostLibraryServiceSetRunnerHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceGetBandwidthProcedure:
+			frostLibraryServiceGetBandwidthHandler.ServeHTTP(w, r)
+		case FrostLibraryServiceSetBandwidthProcedure:
+			frostLibraryServiceSetBandwidthHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -223,3 +762,87 @@ func (UnimplementedFrostLibraryServiceHandler) ListDownloading(context.Context,
 func (UnimplementedFrostLibraryServiceHandler) Download(context.Context, *connect.Request[v1.DownloadRequest]) (*connect.Response[v1.DownloadResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Download is not implemented"))
 }
+
+func (UnimplementedFrostLibraryServiceHandler) Pause(context.Context, *connect.Request[v1.PauseRequest]) (*connect.Response[v1.PauseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Pause is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) Resume(context.Context, *connect.Request[v1.ResumeRequest]) (*connect.Response[v1.ResumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Resume is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) Cancel(context.Context, *connect.Request[v1.CancelRequest]) (*connect.Response[v1.CancelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Cancel is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) SetPriority(context.Context, *connect.Request[v1.SetPriorityRequest]) (*connect.Response[v1.SetPriorityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.SetPriority is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) ListQueue(context.Context, *connect.Request[v1.ListQueueRequest]) (*connect.Response[v1.ListQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.ListQueue is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) WatchProgress(context.Context, *connect.Request[v1.WatchProgressRequest], *connect.ServerStream[v1.WatchProgressResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.WatchProgress is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) Verify(context.Context, *connect.Request[v1.VerifyRequest]) (*connect.Response[v1.VerifyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Verify is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) Repair(context.Context, *connect.Request[v1.RepairRequest]) (*connect.Response[v1.RepairResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Repair is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) ListInstallers(context.Context, *connect.Request[v1.ListInstallersRequest]) (*connect.Response[v1.ListInstallersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.ListInstallers is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[v1.InstallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Install is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) CancelInstall(context.Context, *connect.Request[v1.CancelInstallRequest]) (*connect.Response[v1.CancelInstallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.CancelInstall is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) GetInstallLog(context.Context, *connect.Request[v1.GetInstallLogRequest]) (*connect.Response[v1.GetInstallLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.GetInstallLog is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) SetLaunchOptions(context.Context, *connect.Request[v1.SetLaunchOptionsRequest]) (*connect.Response[v1.SetLaunchOptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.SetLaunchOptions is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) Launch(context.Context, *connect.Request[v1.LaunchRequest]) (*connect.Response[v1.LaunchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.Launch is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) StopGame(context.Context, *connect.Request[v1.StopGameRequest]) (*connect.Response[v1.StopGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.StopGame is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) ListRunning(context.Context, *connect.Request[v1.ListRunningRequest]) (*connect.Response[v1.ListRunningResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.ListRunning is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.ListSessions is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) ListRunners(context.Context, *connect.Request[v1.ListRunnersRequest]) (*connect.Response[v1.ListRunnersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.ListRunners is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) SetRunner(context.Context, *connect.Request[v1.SetRunnerRequest]) (*connect.Response[v1.SetRunnerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.SetRunner is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) GetBandwidth(context.Context, *connect.Request[v1.GetBandwidthRequest]) (*connect.Response[v1.GetBandwidthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.GetBandwidth is not implemented"))
+}
+
+func (UnimplementedFrostLibraryServiceHandler) SetBandwidth(context.Context, *connect.Request[v1.SetBandwidthRequest]) (*connect.Response[v1.SetBandwidthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frost_library.v1.FrostLibraryService.SetBandwidth is not implemented"))
+}
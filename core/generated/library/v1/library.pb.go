@@ -447,10 +447,14 @@ func (*TriggerTrackerResponse) Descriptor() ([]byte, []int) {
 }
 
 type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Offset        uint32                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Query  string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Offset uint32                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// only games the user marked as owned
+	OwnedOnly bool `protobuf:"varint,4,opt,name=ownedOnly,proto3" json:"ownedOnly,omitempty"`
+	// games the user hid are left out unless set
+	IncludeHidden bool `protobuf:"varint,5,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -506,6 +510,20 @@ func (x *ListRequest) GetLimit() uint32 {
 	return 0
 }
 
+func (x *ListRequest) GetOwnedOnly() bool {
+	if x != nil {
+		return x.OwnedOnly
+	}
+	return false
+}
+
+func (x *ListRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
 type ListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameList      []*Game                `protobuf:"bytes,1,rep,name=gameList,proto3" json:"gameList,omitempty"`
@@ -602,6 +620,10 @@ type Game struct {
 	DownloadState *Download              `protobuf:"bytes,7,opt,name=DownloadState,proto3" json:"DownloadState,omitempty"`
 	Meta          *v1.GameMetadata       `protobuf:"bytes,4,opt,name=Meta,proto3" json:"Meta,omitempty"`
 	Source        *v1.GameSource         `protobuf:"bytes,8,opt,name=Source,proto3" json:"Source,omitempty"`
+	// user who added the game, 0 if unknown
+	RequestedBy uint64 `protobuf:"varint,9,opt,name=RequestedBy,proto3" json:"RequestedBy,omitempty"`
+	// Public or Private
+	Visibility    string `protobuf:"bytes,10,opt,name=Visibility,proto3" json:"Visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -678,6 +700,20 @@ func (x *Game) GetSource() *v1.GameSource {
 	return nil
 }
 
+func (x *Game) GetRequestedBy() uint64 {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *Game) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
 type Download struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Client        string                 `protobuf:"bytes,1,opt,name=Client,proto3" json:"Client,omitempty"`
@@ -686,6 +722,8 @@ type Download struct {
 	Progress      string                 `protobuf:"bytes,4,opt,name=Progress,proto3" json:"Progress,omitempty"`
 	Complete      uint64                 `protobuf:"varint,7,opt,name=Complete,proto3" json:"Complete,omitempty"`
 	Left          uint64                 `protobuf:"varint,8,opt,name=Left,proto3" json:"Left,omitempty"`
+	Extracted     uint64                 `protobuf:"varint,9,opt,name=Extracted,proto3" json:"Extracted,omitempty"`
+	ExtractTotal  uint64                 `protobuf:"varint,10,opt,name=ExtractTotal,proto3" json:"ExtractTotal,omitempty"`
 	DownloadPath  string                 `protobuf:"bytes,5,opt,name=DownloadPath,proto3" json:"DownloadPath,omitempty"`
 	DownloadUrl   string                 `protobuf:"bytes,6,opt,name=DownloadUrl,proto3" json:"DownloadUrl,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -764,6 +802,20 @@ func (x *Download) GetLeft() uint64 {
 	return 0
 }
 
+func (x *Download) GetExtracted() uint64 {
+	if x != nil {
+		return x.Extracted
+	}
+	return 0
+}
+
+func (x *Download) GetExtractTotal() uint64 {
+	if x != nil {
+		return x.ExtractTotal
+	}
+	return 0
+}
+
 func (x *Download) GetDownloadPath() string {
 	if x != nil {
 		return x.DownloadPath
@@ -814,65 +866,1538 @@ func (*AddResponse) Descriptor() ([]byte, []int) {
 	return file_library_v1_library_proto_rawDescGZIP(), []int{15}
 }
 
-var File_library_v1_library_proto protoreflect.FileDescriptor
+type WatchDownloadsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only send these games, all games when empty
+	GameIds       []uint64 `protobuf:"varint,1,rep,packed,name=gameIds,proto3" json:"gameIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_library_v1_library_proto_rawDesc = "" +
-	"\n" +
-	"\x18library/v1/library.proto\x12\n" +
-	"library.v1\x1a\x16search/v1/search.proto\"[\n" +
-	"\rExistsRequest\x12&\n" +
-	"\x0eMetadataGameId\x18\x01 \x01(\tR\x0eMetadataGameId\x12\"\n" +
-	"\fMetadataType\x18\x02 \x01(\tR\fMetadataType\"(\n" +
-	"\x0eExistsResponse\x12\x16\n" +
-	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\"'\n" +
-	"\rDeleteRequest\x12\x16\n" +
-	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x10\n" +
-	"\x0eDeleteResponse\",\n" +
-	"\x14ListWithStateRequest\x12\x14\n" +
-	"\x05state\x18\x01 \x01(\tR\x05state\"=\n" +
-	"\x15ListWithStateResponse\x12$\n" +
-	"\x04game\x18\x01 \x03(\v2\x10.library.v1.GameR\x04game\"(\n" +
-	"\x0eGetGameRequest\x12\x16\n" +
-	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\"7\n" +
-	"\x0fGetGameResponse\x12$\n" +
-	"\x04game\x18\x01 \x01(\v2\x10.library.v1.GameR\x04game\"\x17\n" +
-	"\x15TriggerTrackerRequest\"\x18\n" +
-	"\x16TriggerTrackerResponse\"Q\n" +
-	"\vListRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\rR\x06offset\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\rR\x05limit\"<\n" +
-	"\fListResponse\x12,\n" +
-	"\bgameList\x18\x01 \x03(\v2\x10.library.v1.GameR\bgameList\"2\n" +
-	"\n" +
-	"AddRequest\x12$\n" +
-	"\x04game\x18\x01 \x01(\v2\x10.library.v1.GameR\x04game\"\xe8\x01\n" +
-	"\x04Game\x12\x0e\n" +
-	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x1c\n" +
-	"\tCreatedAt\x18\x02 \x01(\tR\tCreatedAt\x12\x1a\n" +
-	"\bEditedAt\x18\x03 \x01(\tR\bEditedAt\x12:\n" +
-	"\rDownloadState\x18\a \x01(\v2\x14.library.v1.DownloadR\rDownloadState\x12+\n" +
-	"\x04Meta\x18\x04 \x01(\v2\x17.search.v1.GameMetadataR\x04Meta\x12-\n" +
-	"\x06Source\x18\b \x01(\v2\x15.search.v1.GameSourceR\x06Source\"\xea\x01\n" +
-	"\bDownload\x12\x16\n" +
-	"\x06Client\x18\x01 \x01(\tR\x06Client\x12\x1e\n" +
-	"\n" +
-	"DownloadId\x18\x02 \x01(\tR\n" +
-	"DownloadId\x12\x14\n" +
-	"\x05State\x18\x03 \x01(\tR\x05State\x12\x1a\n" +
-	"\bProgress\x18\x04 \x01(\tR\bProgress\x12\x1a\n" +
-	"\bComplete\x18\a \x01(\x04R\bComplete\x12\x12\n" +
-	"\x04Left\x18\b \x01(\x04R\x04Left\x12\"\n" +
-	"\fDownloadPath\x18\x05 \x01(\tR\fDownloadPath\x12 \n" +
-	"\vDownloadUrl\x18\x06 \x01(\tR\vDownloadUrl\"\r\n" +
-	"\vAddResponse2\x86\x04\n" +
+func (x *WatchDownloadsRequest) Reset() {
+	*x = WatchDownloadsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDownloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDownloadsRequest) ProtoMessage() {}
+
+func (x *WatchDownloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDownloadsRequest.ProtoReflect.Descriptor instead.
+func (*WatchDownloadsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchDownloadsRequest) GetGameIds() []uint64 {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+type WatchDownloadsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GameId   uint64                 `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Download *Download              `protobuf:"bytes,2,opt,name=download,proto3" json:"download,omitempty"`
+	// set on its own message when events were missed because the stream fell behind,
+	// the downloads should be listed again
+	Dropped       uint64 `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDownloadsResponse) Reset() {
+	*x = WatchDownloadsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDownloadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDownloadsResponse) ProtoMessage() {}
+
+func (x *WatchDownloadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDownloadsResponse.ProtoReflect.Descriptor instead.
+func (*WatchDownloadsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchDownloadsResponse) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *WatchDownloadsResponse) GetDownload() *Download {
+	if x != nil {
+		return x.Download
+	}
+	return nil
+}
+
+func (x *WatchDownloadsResponse) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type ReportPlaytimeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GameId  uint64                 `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Seconds int64                  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// RFC3339 end of the session
+	PlayedAt      string `protobuf:"bytes,3,opt,name=playedAt,proto3" json:"playedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlaytimeRequest) Reset() {
+	*x = ReportPlaytimeRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlaytimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlaytimeRequest) ProtoMessage() {}
+
+func (x *ReportPlaytimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlaytimeRequest.ProtoReflect.Descriptor instead.
+func (*ReportPlaytimeRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReportPlaytimeRequest) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ReportPlaytimeRequest) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *ReportPlaytimeRequest) GetPlayedAt() string {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return ""
+}
+
+type ReportPlaytimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlaytimeResponse) Reset() {
+	*x = ReportPlaytimeResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlaytimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlaytimeResponse) ProtoMessage() {}
+
+func (x *ReportPlaytimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlaytimeResponse.ProtoReflect.Descriptor instead.
+func (*ReportPlaytimeResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{19}
+}
+
+type ListPlaytimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaytimeRequest) Reset() {
+	*x = ListPlaytimeRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaytimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaytimeRequest) ProtoMessage() {}
+
+func (x *ListPlaytimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaytimeRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaytimeRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{20}
+}
+
+type Playtime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint64                 `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Seconds       int64                  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Sessions      int64                  `protobuf:"varint,3,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	LastPlayed    string                 `protobuf:"bytes,4,opt,name=lastPlayed,proto3" json:"lastPlayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Playtime) Reset() {
+	*x = Playtime{}
+	mi := &file_library_v1_library_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Playtime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playtime) ProtoMessage() {}
+
+func (x *Playtime) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playtime.ProtoReflect.Descriptor instead.
+func (*Playtime) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Playtime) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Playtime) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *Playtime) GetSessions() int64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *Playtime) GetLastPlayed() string {
+	if x != nil {
+		return x.LastPlayed
+	}
+	return ""
+}
+
+type ListPlaytimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playtime      []*Playtime            `protobuf:"bytes,1,rep,name=playtime,proto3" json:"playtime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaytimeResponse) Reset() {
+	*x = ListPlaytimeResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaytimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaytimeResponse) ProtoMessage() {}
+
+func (x *ListPlaytimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaytimeResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaytimeResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListPlaytimeResponse) GetPlaytime() []*Playtime {
+	if x != nil {
+		return x.Playtime
+	}
+	return nil
+}
+
+type CollectionEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint64                 `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Owned         bool                   `protobuf:"varint,2,opt,name=owned,proto3" json:"owned,omitempty"`
+	Hidden        bool                   `protobuf:"varint,3,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionEntry) Reset() {
+	*x = CollectionEntry{}
+	mi := &file_library_v1_library_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionEntry) ProtoMessage() {}
+
+func (x *CollectionEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionEntry.ProtoReflect.Descriptor instead.
+func (*CollectionEntry) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CollectionEntry) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *CollectionEntry) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+func (x *CollectionEntry) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type ListCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionRequest) Reset() {
+	*x = ListCollectionRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionRequest) ProtoMessage() {}
+
+func (x *ListCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{24}
+}
+
+type ListCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CollectionEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionResponse) Reset() {
+	*x = ListCollectionResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionResponse) ProtoMessage() {}
+
+func (x *ListCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListCollectionResponse) GetEntries() []*CollectionEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *CollectionEntry       `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionRequest) Reset() {
+	*x = SetCollectionRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionRequest) ProtoMessage() {}
+
+func (x *SetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetCollectionRequest) GetEntry() *CollectionEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SetCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionResponse) Reset() {
+	*x = SetCollectionResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionResponse) ProtoMessage() {}
+
+func (x *SetCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{27}
+}
+
+type GetAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint64                 `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequest) Reset() {
+	*x = GetAccessRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequest) ProtoMessage() {}
+
+func (x *GetAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAccessRequest) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type GetAccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Public or Private
+	Visibility    string   `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	UserIds       []uint64 `protobuf:"varint,2,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+	Roles         []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessResponse) Reset() {
+	*x = GetAccessResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessResponse) ProtoMessage() {}
+
+func (x *GetAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAccessResponse) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *GetAccessResponse) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetAccessResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SetAccessRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId uint64                 `protobuf:"varint,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	// Public or Private
+	Visibility string `protobuf:"bytes,2,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// users and roles that can see the game when it is private
+	UserIds       []uint64 `protobuf:"varint,3,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+	Roles         []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccessRequest) Reset() {
+	*x = SetAccessRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccessRequest) ProtoMessage() {}
+
+func (x *SetAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccessRequest.ProtoReflect.Descriptor instead.
+func (*SetAccessRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetAccessRequest) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SetAccessRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SetAccessRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *SetAccessRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SetAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccessResponse) Reset() {
+	*x = SetAccessResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccessResponse) ProtoMessage() {}
+
+func (x *SetAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccessResponse.ProtoReflect.Descriptor instead.
+func (*SetAccessResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{31}
+}
+
+type GameRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta        *v1.GameMetadata       `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	RequestedBy uint64                 `protobuf:"varint,3,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
+	Note        string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	// Pending, Approved or Denied
+	Status     string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ReviewedBy uint64 `protobuf:"varint,6,opt,name=reviewedBy,proto3" json:"reviewedBy,omitempty"`
+	// why the request was denied
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// game added on approval
+	GameId        uint64 `protobuf:"varint,8,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRequest) Reset() {
+	*x = GameRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRequest) ProtoMessage() {}
+
+func (x *GameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRequest.ProtoReflect.Descriptor instead.
+func (*GameRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GameRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GameRequest) GetMeta() *v1.GameMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GameRequest) GetRequestedBy() uint64 {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *GameRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *GameRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GameRequest) GetReviewedBy() uint64 {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return 0
+}
+
+func (x *GameRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GameRequest) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GameRequest) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type RequestGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *v1.GameMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGameRequest) Reset() {
+	*x = RequestGameRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGameRequest) ProtoMessage() {}
+
+func (x *RequestGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGameRequest.ProtoReflect.Descriptor instead.
+func (*RequestGameRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RequestGameRequest) GetMeta() *v1.GameMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RequestGameRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RequestGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *GameRequest           `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestGameResponse) Reset() {
+	*x = RequestGameResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGameResponse) ProtoMessage() {}
+
+func (x *RequestGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGameResponse.ProtoReflect.Descriptor instead.
+func (*RequestGameResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RequestGameResponse) GetRequest() *GameRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ListRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	All   bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
+	// every status when empty
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequestsRequest) Reset() {
+	*x = ListRequestsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequestsRequest) ProtoMessage() {}
+
+func (x *ListRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRequestsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ListRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*GameRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequestsResponse) Reset() {
+	*x = ListRequestsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequestsResponse) ProtoMessage() {}
+
+func (x *ListRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListRequestsResponse) GetRequests() []*GameRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ApproveRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source        *v1.GameSource         `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRequestRequest) Reset() {
+	*x = ApproveRequestRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRequestRequest) ProtoMessage() {}
+
+func (x *ApproveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ApproveRequestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveRequestRequest) GetSource() *v1.GameSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type ApproveRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *GameRequest           `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRequestResponse) Reset() {
+	*x = ApproveRequestResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRequestResponse) ProtoMessage() {}
+
+func (x *ApproveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ApproveRequestResponse) GetRequest() *GameRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DenyRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyRequestRequest) Reset() {
+	*x = DenyRequestRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyRequestRequest) ProtoMessage() {}
+
+func (x *DenyRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyRequestRequest.ProtoReflect.Descriptor instead.
+func (*DenyRequestRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DenyRequestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DenyRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DenyRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *GameRequest           `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyRequestResponse) Reset() {
+	*x = DenyRequestResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyRequestResponse) ProtoMessage() {}
+
+func (x *DenyRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyRequestResponse.ProtoReflect.Descriptor instead.
+func (*DenyRequestResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DenyRequestResponse) GetRequest() *GameRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type CancelRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequestRequest) Reset() {
+	*x = CancelRequestRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequestRequest) ProtoMessage() {}
+
+func (x *CancelRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequestRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelRequestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequestResponse) Reset() {
+	*x = CancelRequestResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequestResponse) ProtoMessage() {}
+
+func (x *CancelRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelRequestResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{42}
+}
+
+var File_library_v1_library_proto protoreflect.FileDescriptor
+
+const file_library_v1_library_proto_rawDesc = "" +
+	"\n" +
+	"\x18library/v1/library.proto\x12\n" +
+	"library.v1\x1a\x16search/v1/search.proto\"[\n" +
+	"\rExistsRequest\x12&\n" +
+	"\x0eMetadataGameId\x18\x01 \x01(\tR\x0eMetadataGameId\x12\"\n" +
+	"\fMetadataType\x18\x02 \x01(\tR\fMetadataType\"(\n" +
+	"\x0eExistsResponse\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\"'\n" +
+	"\rDeleteRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x03R\x06gameId\"\x10\n" +
+	"\x0eDeleteResponse\",\n" +
+	"\x14ListWithStateRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\"=\n" +
+	"\x15ListWithStateResponse\x12$\n" +
+	"\x04game\x18\x01 \x03(\v2\x10.library.v1.GameR\x04game\"(\n" +
+	"\x0eGetGameRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\"7\n" +
+	"\x0fGetGameResponse\x12$\n" +
+	"\x04game\x18\x01 \x01(\v2\x10.library.v1.GameR\x04game\"\x17\n" +
+	"\x15TriggerTrackerRequest\"\x18\n" +
+	"\x16TriggerTrackerResponse\"\x95\x01\n" +
+	"\vListRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\rR\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x1c\n" +
+	"\townedOnly\x18\x04 \x01(\bR\townedOnly\x12$\n" +
+	"\rincludeHidden\x18\x05 \x01(\bR\rincludeHidden\"<\n" +
+	"\fListResponse\x12,\n" +
+	"\bgameList\x18\x01 \x03(\v2\x10.library.v1.GameR\bgameList\"2\n" +
+	"\n" +
+	"AddRequest\x12$\n" +
+	"\x04game\x18\x01 \x01(\v2\x10.library.v1.GameR\x04game\"\xaa\x02\n" +
+	"\x04Game\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x1c\n" +
+	"\tCreatedAt\x18\x02 \x01(\tR\tCreatedAt\x12\x1a\n" +
+	"\bEditedAt\x18\x03 \x01(\tR\bEditedAt\x12:\n" +
+	"\rDownloadState\x18\a \x01(\v2\x14.library.v1.DownloadR\rDownloadState\x12+\n" +
+	"\x04Meta\x18\x04 \x01(\v2\x17.search.v1.GameMetadataR\x04Meta\x12-\n" +
+	"\x06Source\x18\b \x01(\v2\x15.search.v1.GameSourceR\x06Source\x12 \n" +
+	"\vRequestedBy\x18\t \x01(\x04R\vRequestedBy\x12\x1e\n" +
+	"\n" +
+	"Visibility\x18\n" +
+	" \x01(\tR\n" +
+	"Visibility\"\xac\x02\n" +
+	"\bDownload\x12\x16\n" +
+	"\x06Client\x18\x01 \x01(\tR\x06Client\x12\x1e\n" +
+	"\n" +
+	"DownloadId\x18\x02 \x01(\tR\n" +
+	"DownloadId\x12\x14\n" +
+	"\x05State\x18\x03 \x01(\tR\x05State\x12\x1a\n" +
+	"\bProgress\x18\x04 \x01(\tR\bProgress\x12\x1a\n" +
+	"\bComplete\x18\a \x01(\x04R\bComplete\x12\x12\n" +
+	"\x04Left\x18\b \x01(\x04R\x04Left\x12\x1c\n" +
+	"\tExtracted\x18\t \x01(\x04R\tExtracted\x12\"\n" +
+	"\fExtractTotal\x18\n" +
+	" \x01(\x04R\fExtractTotal\x12\"\n" +
+	"\fDownloadPath\x18\x05 \x01(\tR\fDownloadPath\x12 \n" +
+	"\vDownloadUrl\x18\x06 \x01(\tR\vDownloadUrl\"\r\n" +
+	"\vAddResponse\"1\n" +
+	"\x15WatchDownloadsRequest\x12\x18\n" +
+	"\agameIds\x18\x01 \x03(\x04R\agameIds\"|\n" +
+	"\x16WatchDownloadsResponse\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\x120\n" +
+	"\bdownload\x18\x02 \x01(\v2\x14.library.v1.DownloadR\bdownload\x12\x18\n" +
+	"\adropped\x18\x03 \x01(\x04R\adropped\"e\n" +
+	"\x15ReportPlaytimeRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x03R\aseconds\x12\x1a\n" +
+	"\bplayedAt\x18\x03 \x01(\tR\bplayedAt\"\x18\n" +
+	"\x16ReportPlaytimeResponse\"\x15\n" +
+	"\x13ListPlaytimeRequest\"x\n" +
+	"\bPlaytime\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x03R\aseconds\x12\x1a\n" +
+	"\bsessions\x18\x03 \x01(\x03R\bsessions\x12\x1e\n" +
+	"\n" +
+	"lastPlayed\x18\x04 \x01(\tR\n" +
+	"lastPlayed\"H\n" +
+	"\x14ListPlaytimeResponse\x120\n" +
+	"\bplaytime\x18\x01 \x03(\v2\x14.library.v1.PlaytimeR\bplaytime\"W\n" +
+	"\x0fCollectionEntry\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\x12\x14\n" +
+	"\x05owned\x18\x02 \x01(\bR\x05owned\x12\x16\n" +
+	"\x06hidden\x18\x03 \x01(\bR\x06hidden\"\x17\n" +
+	"\x15ListCollectionRequest\"O\n" +
+	"\x16ListCollectionResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.library.v1.CollectionEntryR\aentries\"I\n" +
+	"\x14SetCollectionRequest\x121\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1b.library.v1.CollectionEntryR\x05entry\"\x17\n" +
+	"\x15SetCollectionResponse\"*\n" +
+	"\x10GetAccessRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\"c\n" +
+	"\x11GetAccessResponse\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\tR\n" +
+	"visibility\x12\x18\n" +
+	"\auserIds\x18\x02 \x03(\x04R\auserIds\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\"z\n" +
+	"\x10SetAccessRequest\x12\x16\n" +
+	"\x06gameId\x18\x01 \x01(\x04R\x06gameId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\tR\n" +
+	"visibility\x12\x18\n" +
+	"\auserIds\x18\x03 \x03(\x04R\auserIds\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\"\x13\n" +
+	"\x11SetAccessResponse\"\xa4\x02\n" +
+	"\vGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12+\n" +
+	"\x04meta\x18\x02 \x01(\v2\x17.search.v1.GameMetadataR\x04meta\x12 \n" +
+	"\vrequestedBy\x18\x03 \x01(\x04R\vrequestedBy\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"reviewedBy\x18\x06 \x01(\x04R\n" +
+	"reviewedBy\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x16\n" +
+	"\x06gameId\x18\b \x01(\x04R\x06gameId\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\tR\tupdatedAt\"U\n" +
+	"\x12RequestGameRequest\x12+\n" +
+	"\x04meta\x18\x01 \x01(\v2\x17.search.v1.GameMetadataR\x04meta\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"H\n" +
+	"\x13RequestGameResponse\x121\n" +
+	"\arequest\x18\x01 \x01(\v2\x17.library.v1.GameRequestR\arequest\"?\n" +
+	"\x13ListRequestsRequest\x12\x10\n" +
+	"\x03all\x18\x01 \x01(\bR\x03all\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"K\n" +
+	"\x14ListRequestsResponse\x123\n" +
+	"\brequests\x18\x01 \x03(\v2\x17.library.v1.GameRequestR\brequests\"V\n" +
+	"\x15ApproveRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12-\n" +
+	"\x06source\x18\x02 \x01(\v2\x15.search.v1.GameSourceR\x06source\"K\n" +
+	"\x16ApproveRequestResponse\x121\n" +
+	"\arequest\x18\x01 \x01(\v2\x17.library.v1.GameRequestR\arequest\"<\n" +
+	"\x12DenyRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"H\n" +
+	"\x13DenyRequestResponse\x121\n" +
+	"\arequest\x18\x01 \x01(\v2\x17.library.v1.GameRequestR\arequest\"&\n" +
+	"\x14CancelRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x17\n" +
+	"\x15CancelRequestResponse2\x8a\f\n" +
 	"\x0eLibraryService\x12;\n" +
 	"\x04List\x12\x17.library.v1.ListRequest\x1a\x18.library.v1.ListResponse\"\x00\x12V\n" +
 	"\rListWithState\x12 .library.v1.ListWithStateRequest\x1a!.library.v1.ListWithStateResponse\"\x00\x12A\n" +
 	"\x06Delete\x12\x19.library.v1.DeleteRequest\x1a\x1a.library.v1.DeleteResponse\"\x00\x12A\n" +
 	"\x06Exists\x12\x19.library.v1.ExistsRequest\x1a\x1a.library.v1.ExistsResponse\"\x00\x12Y\n" +
-	"\x0eTriggerTracker\x12!.library.v1.TriggerTrackerRequest\x1a\".library.v1.TriggerTrackerResponse\"\x00\x12D\n" +
-	"\aGetGame\x12\x1a.library.v1.GetGameRequest\x1a\x1b.library.v1.GetGameResponse\"\x00\x128\n" +
+	"\x0eTriggerTracker\x12!.library.v1.TriggerTrackerRequest\x1a\".library.v1.TriggerTrackerResponse\"\x00\x12[\n" +
+	"\x0eWatchDownloads\x12!.library.v1.WatchDownloadsRequest\x1a\".library.v1.WatchDownloadsResponse\"\x000\x01\x12Y\n" +
+	"\x0eReportPlaytime\x12!.library.v1.ReportPlaytimeRequest\x1a\".library.v1.ReportPlaytimeResponse\"\x00\x12S\n" +
+	"\fListPlaytime\x12\x1f.library.v1.ListPlaytimeRequest\x1a .library.v1.ListPlaytimeResponse\"\x00\x12D\n" +
+	"\aGetGame\x12\x1a.library.v1.GetGameRequest\x1a\x1b.library.v1.GetGameResponse\"\x00\x12Y\n" +
+	"\x0eListCollection\x12!.library.v1.ListCollectionRequest\x1a\".library.v1.ListCollectionResponse\"\x00\x12V\n" +
+	"\rSetCollection\x12 .library.v1.SetCollectionRequest\x1a!.library.v1.SetCollectionResponse\"\x00\x12J\n" +
+	"\tGetAccess\x12\x1c.library.v1.GetAccessRequest\x1a\x1d.library.v1.GetAccessResponse\"\x00\x12J\n" +
+	"\tSetAccess\x12\x1c.library.v1.SetAccessRequest\x1a\x1d.library.v1.SetAccessResponse\"\x00\x12P\n" +
+	"\vRequestGame\x12\x1e.library.v1.RequestGameRequest\x1a\x1f.library.v1.RequestGameResponse\"\x00\x12S\n" +
+	"\fListRequests\x12\x1f.library.v1.ListRequestsRequest\x1a .library.v1.ListRequestsResponse\"\x00\x12Y\n" +
+	"\x0eApproveRequest\x12!.library.v1.ApproveRequestRequest\x1a\".library.v1.ApproveRequestResponse\"\x00\x12P\n" +
+	"\vDenyRequest\x12\x1e.library.v1.DenyRequestRequest\x1a\x1f.library.v1.DenyRequestResponse\"\x00\x12V\n" +
+	"\rCancelRequest\x12 .library.v1.CancelRequestRequest\x1a!.library.v1.CancelRequestResponse\"\x00\x128\n" +
 	"\x03Add\x12\x16.library.v1.AddRequest\x1a\x17.library.v1.AddResponse\"\x00B\x96\x01\n" +
 	"\x0ecom.library.v1B\fLibraryProtoP\x01Z-github.com/ra341/glacier/generated/library/v1\xa2\x02\x03LXX\xaa\x02\n" +
 	"Library.V1\xca\x02\n" +
@@ -890,7 +2415,7 @@ func file_library_v1_library_proto_rawDescGZIP() []byte {
 	return file_library_v1_library_proto_rawDescData
 }
 
-var file_library_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_library_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_library_v1_library_proto_goTypes = []any{
 	(*ExistsRequest)(nil),          // 0: library.v1.ExistsRequest
 	(*ExistsResponse)(nil),         // 1: library.v1.ExistsResponse
@@ -908,8 +2433,35 @@ var file_library_v1_library_proto_goTypes = []any{
 	(*Game)(nil),                   // 13: library.v1.Game
 	(*Download)(nil),               // 14: library.v1.Download
 	(*AddResponse)(nil),            // 15: library.v1.AddResponse
-	(*v1.GameMetadata)(nil),        // 16: search.v1.GameMetadata
-	(*v1.GameSource)(nil),          // 17: search.v1.GameSource
+	(*WatchDownloadsRequest)(nil),  // 16: library.v1.WatchDownloadsRequest
+	(*WatchDownloadsResponse)(nil), // 17: library.v1.WatchDownloadsResponse
+	(*ReportPlaytimeRequest)(nil),  // 18: library.v1.ReportPlaytimeRequest
+	(*ReportPlaytimeResponse)(nil), // 19: library.v1.ReportPlaytimeResponse
+	(*ListPlaytimeRequest)(nil),    // 20: library.v1.ListPlaytimeRequest
+	(*Playtime)(nil),               // 21: library.v1.Playtime
+	(*ListPlaytimeResponse)(nil),   // 22: library.v1.ListPlaytimeResponse
+	(*CollectionEntry)(nil),        // 23: library.v1.CollectionEntry
+	(*ListCollectionRequest)(nil),  // 24: library.v1.ListCollectionRequest
+	(*ListCollectionResponse)(nil), // 25: library.v1.ListCollectionResponse
+	(*SetCollectionRequest)(nil),   // 26: library.v1.SetCollectionRequest
+	(*SetCollectionResponse)(nil),  // 27: library.v1.SetCollectionResponse
+	(*GetAccessRequest)(nil),       // 28: library.v1.GetAccessRequest
+	(*GetAccessResponse)(nil),      // 29: library.v1.GetAccessResponse
+	(*SetAccessRequest)(nil),       // 30: library.v1.SetAccessRequest
+	(*SetAccessResponse)(nil),      // 31: library.v1.SetAccessResponse
+	(*GameRequest)(nil),            // 32: library.v1.GameRequest
+	(*RequestGameRequest)(nil),     // 33: library.v1.RequestGameRequest
+	(*RequestGameResponse)(nil),    // 34: library.v1.RequestGameResponse
+	(*ListRequestsRequest)(nil),    // 35: library.v1.ListRequestsRequest
+	(*ListRequestsResponse)(nil),   // 36: library.v1.ListRequestsResponse
+	(*ApproveRequestRequest)(nil),  // 37: library.v1.ApproveRequestRequest
+	(*ApproveRequestResponse)(nil), // 38: library.v1.ApproveRequestResponse
+	(*DenyRequestRequest)(nil),     // 39: library.v1.DenyRequestRequest
+	(*DenyRequestResponse)(nil),    // 40: library.v1.DenyRequestResponse
+	(*CancelRequestRequest)(nil),   // 41: library.v1.CancelRequestRequest
+	(*CancelRequestResponse)(nil),  // 42: library.v1.CancelRequestResponse
+	(*v1.GameMetadata)(nil),        // 43: search.v1.GameMetadata
+	(*v1.GameSource)(nil),          // 44: search.v1.GameSource
 }
 var file_library_v1_library_proto_depIdxs = []int32{
 	13, // 0: library.v1.ListWithStateResponse.game:type_name -> library.v1.Game
@@ -917,27 +2469,62 @@ var file_library_v1_library_proto_depIdxs = []int32{
 	13, // 2: library.v1.ListResponse.gameList:type_name -> library.v1.Game
 	13, // 3: library.v1.AddRequest.game:type_name -> library.v1.Game
 	14, // 4: library.v1.Game.DownloadState:type_name -> library.v1.Download
-	16, // 5: library.v1.Game.Meta:type_name -> search.v1.GameMetadata
-	17, // 6: library.v1.Game.Source:type_name -> search.v1.GameSource
-	10, // 7: library.v1.LibraryService.List:input_type -> library.v1.ListRequest
-	4,  // 8: library.v1.LibraryService.ListWithState:input_type -> library.v1.ListWithStateRequest
-	2,  // 9: library.v1.LibraryService.Delete:input_type -> library.v1.DeleteRequest
-	0,  // 10: library.v1.LibraryService.Exists:input_type -> library.v1.ExistsRequest
-	8,  // 11: library.v1.LibraryService.TriggerTracker:input_type -> library.v1.TriggerTrackerRequest
-	6,  // 12: library.v1.LibraryService.GetGame:input_type -> library.v1.GetGameRequest
-	12, // 13: library.v1.LibraryService.Add:input_type -> library.v1.AddRequest
-	11, // 14: library.v1.LibraryService.List:output_type -> library.v1.ListResponse
-	5,  // 15: library.v1.LibraryService.ListWithState:output_type -> library.v1.ListWithStateResponse
-	3,  // 16: library.v1.LibraryService.Delete:output_type -> library.v1.DeleteResponse
-	1,  // 17: library.v1.LibraryService.Exists:output_type -> library.v1.ExistsResponse
-	9,  // 18: library.v1.LibraryService.TriggerTracker:output_type -> library.v1.TriggerTrackerResponse
-	7,  // 19: library.v1.LibraryService.GetGame:output_type -> library.v1.GetGameResponse
-	15, // 20: library.v1.LibraryService.Add:output_type -> library.v1.AddResponse
-	14, // [14:21] is the sub-list for method output_type
-	7,  // [7:14] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	43, // 5: library.v1.Game.Meta:type_name -> search.v1.GameMetadata
+	44, // 6: library.v1.Game.Source:type_name -> search.v1.GameSource
+	14, // 7: library.v1.WatchDownloadsResponse.download:type_name -> library.v1.Download
+	21, // 8: library.v1.ListPlaytimeResponse.playtime:type_name -> library.v1.Playtime
+	23, // 9: library.v1.ListCollectionResponse.entries:type_name -> library.v1.CollectionEntry
+	23, // 10: library.v1.SetCollectionRequest.entry:type_name -> library.v1.CollectionEntry
+	43, // 11: library.v1.GameRequest.meta:type_name -> search.v1.GameMetadata
+	43, // 12: library.v1.RequestGameRequest.meta:type_name -> search.v1.GameMetadata
+	32, // 13: library.v1.RequestGameResponse.request:type_name -> library.v1.GameRequest
+	32, // 14: library.v1.ListRequestsResponse.requests:type_name -> library.v1.GameRequest
+	44, // 15: library.v1.ApproveRequestRequest.source:type_name -> search.v1.GameSource
+	32, // 16: library.v1.ApproveRequestResponse.request:type_name -> library.v1.GameRequest
+	32, // 17: library.v1.DenyRequestResponse.request:type_name -> library.v1.GameRequest
+	10, // 18: library.v1.LibraryService.List:input_type -> library.v1.ListRequest
+	4,  // 19: library.v1.LibraryService.ListWithState:input_type -> library.v1.ListWithStateRequest
+	2,  // 20: library.v1.LibraryService.Delete:input_type -> library.v1.DeleteRequest
+	0,  // 21: library.v1.LibraryService.Exists:input_type -> library.v1.ExistsRequest
+	8,  // 22: library.v1.LibraryService.TriggerTracker:input_type -> library.v1.TriggerTrackerRequest
+	16, // 23: library.v1.LibraryService.WatchDownloads:input_type -> library.v1.WatchDownloadsRequest
+	18, // 24: library.v1.LibraryService.ReportPlaytime:input_type -> library.v1.ReportPlaytimeRequest
+	20, // 25: library.v1.LibraryService.ListPlaytime:input_type -> library.v1.ListPlaytimeRequest
+	6,  // 26: library.v1.LibraryService.GetGame:input_type -> library.v1.GetGameRequest
+	24, // 27: library.v1.LibraryService.ListCollection:input_type -> library.v1.ListCollectionRequest
+	26, // 28: library.v1.LibraryService.SetCollection:input_type -> library.v1.SetCollectionRequest
+	28, // 29: library.v1.LibraryService.GetAccess:input_type -> library.v1.GetAccessRequest
+	30, // 30: library.v1.LibraryService.SetAccess:input_type -> library.v1.SetAccessRequest
+	33, // 31: library.v1.LibraryService.RequestGame:input_type -> library.v1.RequestGameRequest
+	35, // 32: library.v1.LibraryService.ListRequests:input_type -> library.v1.ListRequestsRequest
+	37, // 33: library.v1.LibraryService.ApproveRequest:input_type -> library.v1.ApproveRequestRequest
+	39, // 34: library.v1.LibraryService.DenyRequest:input_type -> library.v1.DenyRequestRequest
+	41, // 35: library.v1.LibraryService.CancelRequest:input_type -> library.v1.CancelRequestRequest
+	12, // 36: library.v1.LibraryService.Add:input_type -> library.v1.AddRequest
+	11, // 37: library.v1.LibraryService.List:output_type -> library.v1.ListResponse
+	5,  // 38: library.v1.LibraryService.ListWithState:output_type -> library.v1.ListWithStateResponse
+	3,  // 39: library.v1.LibraryService.Delete:output_type -> library.v1.DeleteResponse
+	1,  // 40: library.v1.LibraryService.Exists:output_type -> library.v1.ExistsResponse
+	9,  // 41: library.v1.LibraryService.TriggerTracker:output_type -> library.v1.TriggerTrackerResponse
+	17, // 42: library.v1.LibraryService.WatchDownloads:output_type -> library.v1.WatchDownloadsResponse
+	19, // 43: library.v1.LibraryService.ReportPlaytime:output_type -> library.v1.ReportPlaytimeResponse
+	22, // 44: library.v1.LibraryService.ListPlaytime:output_type -> library.v1.ListPlaytimeResponse
+	7,  // 45: library.v1.LibraryService.GetGame:output_type -> library.v1.GetGameResponse
+	25, // 46: library.v1.LibraryService.ListCollection:output_type -> library.v1.ListCollectionResponse
+	27, // 47: library.v1.LibraryService.SetCollection:output_type -> library.v1.SetCollectionResponse
+	29, // 48: library.v1.LibraryService.GetAccess:output_type -> library.v1.GetAccessResponse
+	31, // 49: library.v1.LibraryService.SetAccess:output_type -> library.v1.SetAccessResponse
+	34, // 50: library.v1.LibraryService.RequestGame:output_type -> library.v1.RequestGameResponse
+	36, // 51: library.v1.LibraryService.ListRequests:output_type -> library.v1.ListRequestsResponse
+	38, // 52: library.v1.LibraryService.ApproveRequest:output_type -> library.v1.ApproveRequestResponse
+	40, // 53: library.v1.LibraryService.DenyRequest:output_type -> library.v1.DenyRequestResponse
+	42, // 54: library.v1.LibraryService.CancelRequest:output_type -> library.v1.CancelRequestResponse
+	15, // 55: library.v1.LibraryService.Add:output_type -> library.v1.AddResponse
+	37, // [37:56] is the sub-list for method output_type
+	18, // [18:37] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_library_v1_library_proto_init() }
@@ -951,7 +2538,7 @@ func file_library_v1_library_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_library_v1_library_proto_rawDesc), len(file_library_v1_library_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: library/v1/manifest.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Manifest lists every file of a downloaded game,
+// served by GET /meta/{game} as protobuf or JSON depending on the Accept header
+type Manifest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bumped on breaking changes, readers should reject versions they do not know
+	Version       uint32          `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	GameId        uint64          `protobuf:"varint,2,opt,name=GameId,proto3" json:"GameId,omitempty"`
+	TotalSize     int64           `protobuf:"varint,3,opt,name=TotalSize,proto3" json:"TotalSize,omitempty"`
+	Files         []*FileManifest `protobuf:"bytes,4,rep,name=Files,proto3" json:"Files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Manifest) Reset() {
+	*x = Manifest{}
+	mi := &file_library_v1_manifest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manifest) ProtoMessage() {}
+
+func (x *Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_manifest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return file_library_v1_manifest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Manifest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Manifest) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Manifest) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *Manifest) GetFiles() []*FileManifest {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FileManifest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// slash separated path inside the game folder
+	RelPath string `protobuf:"bytes,1,opt,name=RelPath,proto3" json:"RelPath,omitempty"`
+	Size    int64  `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	// RFC3339 with nanoseconds
+	ModTime string `protobuf:"bytes,3,opt,name=ModTime,proto3" json:"ModTime,omitempty"`
+	// xxhash64 of the whole file as a decimal string
+	Checksum string `protobuf:"bytes,4,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
+	// size of each block, the last one can be shorter
+	BlockSize int64 `protobuf:"varint,5,opt,name=BlockSize,proto3" json:"BlockSize,omitempty"`
+	// xxhash64 of each block as a decimal string
+	Blocks        []string `protobuf:"bytes,6,rep,name=Blocks,proto3" json:"Blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileManifest) Reset() {
+	*x = FileManifest{}
+	mi := &file_library_v1_manifest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileManifest) ProtoMessage() {}
+
+func (x *FileManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_manifest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileManifest.ProtoReflect.Descriptor instead.
+func (*FileManifest) Descriptor() ([]byte, []int) {
+	return file_library_v1_manifest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FileManifest) GetRelPath() string {
+	if x != nil {
+		return x.RelPath
+	}
+	return ""
+}
+
+func (x *FileManifest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileManifest) GetModTime() string {
+	if x != nil {
+		return x.ModTime
+	}
+	return ""
+}
+
+func (x *FileManifest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *FileManifest) GetBlockSize() int64 {
+	if x != nil {
+		return x.BlockSize
+	}
+	return 0
+}
+
+func (x *FileManifest) GetBlocks() []string {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+var File_library_v1_manifest_proto protoreflect.FileDescriptor
+
+const file_library_v1_manifest_proto_rawDesc = "" +
+	"\n" +
+	"\x19library/v1/manifest.proto\x12\n" +
+	"library.v1\"\x8a\x01\n" +
+	"\bManifest\x12\x18\n" +
+	"\aVersion\x18\x01 \x01(\rR\aVersion\x12\x16\n" +
+	"\x06GameId\x18\x02 \x01(\x04R\x06GameId\x12\x1c\n" +
+	"\tTotalSize\x18\x03 \x01(\x03R\tTotalSize\x12.\n" +
+	"\x05Files\x18\x04 \x03(\v2\x18.library.v1.FileManifestR\x05Files\"\xa8\x01\n" +
+	"\fFileManifest\x12\x18\n" +
+	"\aRelPath\x18\x01 \x01(\tR\aRelPath\x12\x12\n" +
+	"\x04Size\x18\x02 \x01(\x03R\x04Size\x12\x18\n" +
+	"\aModTime\x18\x03 \x01(\tR\aModTime\x12\x1a\n" +
+	"\bChecksum\x18\x04 \x01(\tR\bChecksum\x12\x1c\n" +
+	"\tBlockSize\x18\x05 \x01(\x03R\tBlockSize\x12\x16\n" +
+	"\x06Blocks\x18\x06 \x03(\tR\x06BlocksB\x97\x01\n" +
+	"\x0ecom.library.v1B\rManifestProtoP\x01Z-github.com/ra341/glacier/generated/library/v1\xa2\x02\x03LXX\xaa\x02\n" +
+	"Library.V1\xca\x02\n" +
+	"Library\\V1\xe2\x02\x16Library\\V1\\GPBMetadata\xea\x02\vLibrary::V1b\x06proto3"
+
+var (
+	file_library_v1_manifest_proto_rawDescOnce sync.Once
+	file_library_v1_manifest_proto_rawDescData []byte
+)
+
+func file_library_v1_manifest_proto_rawDescGZIP() []byte {
+	file_library_v1_manifest_proto_rawDescOnce.Do(func() {
+		file_library_v1_manifest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_library_v1_manifest_proto_rawDesc), len(file_library_v1_manifest_proto_rawDesc)))
+	})
+	return file_library_v1_manifest_proto_rawDescData
+}
+
+var file_library_v1_manifest_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_library_v1_manifest_proto_goTypes = []any{
+	(*Manifest)(nil),     // 0: library.v1.Manifest
+	(*FileManifest)(nil), // 1: library.v1.FileManifest
+}
+var file_library_v1_manifest_proto_depIdxs = []int32{
+	1, // 0: library.v1.Manifest.Files:type_name -> library.v1.FileManifest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_library_v1_manifest_proto_init() }
+func file_library_v1_manifest_proto_init() {
+	if File_library_v1_manifest_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_library_v1_manifest_proto_rawDesc), len(file_library_v1_manifest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_library_v1_manifest_proto_goTypes,
+		DependencyIndexes: file_library_v1_manifest_proto_depIdxs,
+		MessageInfos:      file_library_v1_manifest_proto_msgTypes,
+	}.Build()
+	File_library_v1_manifest_proto = out.File
+	file_library_v1_manifest_proto_goTypes = nil
+	file_library_v1_manifest_proto_depIdxs = nil
+}
@@ -45,8 +45,44 @@ const (
 	// LibraryServiceTriggerTrackerProcedure is the fully-qualified name of the LibraryService's
 	// TriggerTracker RPC.
 	LibraryServiceTriggerTrackerProcedure = "/library.v1.LibraryService/TriggerTracker"
+	// LibraryServiceWatchDownloadsProcedure is the fully-qualified name of the LibraryService's
+	// WatchDownloads RPC.
+	LibraryServiceWatchDownloadsProcedure = "/library.v1.LibraryService/WatchDownloads"
+	// LibraryServiceReportPlaytimeProcedure is the fully-qualified name of the LibraryService's
+	// ReportPlaytime RPC.
+	LibraryServiceReportPlaytimeProcedure = "/library.v1.LibraryService/ReportPlaytime"
+	// LibraryServiceListPlaytimeProcedure is the fully-qualified name of the LibraryService's
+	// ListPlaytime RPC.
+	LibraryServiceListPlaytimeProcedure = "/library.v1.LibraryService/ListPlaytime"
 	// LibraryServiceGetGameProcedure is the fully-qualified name of the LibraryService's GetGame RPC.
 	LibraryServiceGetGameProcedure = "/library.v1.LibraryService/GetGame"
+	// LibraryServiceListCollectionProcedure is the fully-qualified name of the LibraryService's
+	// ListCollection RPC.
+	LibraryServiceListCollectionProcedure = "/library.v1.LibraryService/ListCollection"
+	// LibraryServiceSetCollectionProcedure is the fully-qualified name of the LibraryService's
+	// SetCollection RPC.
+	LibraryServiceSetCollectionProcedure = "/library.v1.LibraryService/SetCollection"
+	// LibraryServiceGetAccessProcedure is the fully-qualified name of the LibraryService's GetAccess
+	// RPC.
+	LibraryServiceGetAccessProcedure = "/library.v1.LibraryService/GetAccess"
+	// LibraryServiceSetAccessProcedure is the fully-qualified name of the LibraryService's SetAccess
+	// RPC.
+	LibraryServiceSetAccessProcedure = "/library.v1.LibraryService/SetAccess"
+	// LibraryServiceRequestGameProcedure is the fully-qualified name of the LibraryService's
+	// RequestGame RPC.
+	LibraryServiceRequestGameProcedure = "/library.v1.LibraryService/RequestGame"
+	// LibraryServiceListRequestsProcedure is the fully-qualified name of the LibraryService's
+	// ListRequests RPC.
+	LibraryServiceListRequestsProcedure = "/library.v1.LibraryService/ListRequests"
+	// LibraryServiceApproveRequestProcedure is the fully-qualified name of the LibraryService's
+	// ApproveRequest RPC.
+	LibraryServiceApproveRequestProcedure = "/library.v1.LibraryService/ApproveRequest"
+	// LibraryServiceDenyRequestProcedure is the fully-qualified name of the LibraryService's
+	// DenyRequest RPC.
+	LibraryServiceDenyRequestProcedure = "/library.v1.LibraryService/DenyRequest"
+	// LibraryServiceCancelRequestProcedure is the fully-qualified name of the LibraryService's
+	// CancelRequest RPC.
+	LibraryServiceCancelRequestProcedure = "/library.v1.LibraryService/CancelRequest"
 	// LibraryServiceAddProcedure is the fully-qualified name of the LibraryService's Add RPC.
 	LibraryServiceAddProcedure = "/library.v1.LibraryService/Add"
 )
@@ -58,7 +94,28 @@ type LibraryServiceClient interface {
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
 	Exists(context.Context, *connect.Request[v1.ExistsRequest]) (*connect.Response[v1.ExistsResponse], error)
 	TriggerTracker(context.Context, *connect.Request[v1.TriggerTrackerRequest]) (*connect.Response[v1.TriggerTrackerResponse], error)
+	// streams the download state of games as it changes
+	WatchDownloads(context.Context, *connect.Request[v1.WatchDownloadsRequest]) (*connect.ServerStreamForClient[v1.WatchDownloadsResponse], error)
+	// adds a play session from frost to the playtime of the user
+	ReportPlaytime(context.Context, *connect.Request[v1.ReportPlaytimeRequest]) (*connect.Response[v1.ReportPlaytimeResponse], error)
+	ListPlaytime(context.Context, *connect.Request[v1.ListPlaytimeRequest]) (*connect.Response[v1.ListPlaytimeResponse], error)
 	GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error)
+	// owned and hidden flags of the games in the collection of the user
+	ListCollection(context.Context, *connect.Request[v1.ListCollectionRequest]) (*connect.Response[v1.ListCollectionResponse], error)
+	SetCollection(context.Context, *connect.Request[v1.SetCollectionRequest]) (*connect.Response[v1.SetCollectionResponse], error)
+	// users and roles that can see a private game
+	GetAccess(context.Context, *connect.Request[v1.GetAccessRequest]) (*connect.Response[v1.GetAccessResponse], error)
+	// only admins and the user who requested the game can change who sees it
+	SetAccess(context.Context, *connect.Request[v1.SetAccessRequest]) (*connect.Response[v1.SetAccessResponse], error)
+	// asks for a game to be added, users with requests:manage approve or deny it
+	RequestGame(context.Context, *connect.Request[v1.RequestGameRequest]) (*connect.Response[v1.RequestGameResponse], error)
+	// requests of the user, every request with all set
+	ListRequests(context.Context, *connect.Request[v1.ListRequestsRequest]) (*connect.Response[v1.ListRequestsResponse], error)
+	// adds the game with the source and starts the download
+	ApproveRequest(context.Context, *connect.Request[v1.ApproveRequestRequest]) (*connect.Response[v1.ApproveRequestResponse], error)
+	DenyRequest(context.Context, *connect.Request[v1.DenyRequestRequest]) (*connect.Response[v1.DenyRequestResponse], error)
+	// deletes a pending request
+	CancelRequest(context.Context, *connect.Request[v1.CancelRequestRequest]) (*connect.Response[v1.CancelRequestResponse], error)
 	Add(context.Context, *connect.Request[v1.AddRequest]) (*connect.Response[v1.AddResponse], error)
 }
 
@@ -103,12 +160,84 @@ func NewLibraryServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(libraryServiceMethods.ByName("TriggerTracker")),
 			connect.WithClientOptions(opts...),
 		),
+		watchDownloads: connect.NewClient[v1.WatchDownloadsRequest, v1.WatchDownloadsResponse](
+			httpClient,
+			baseURL+LibraryServiceWatchDownloadsProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("WatchDownloads")),
+			connect.WithClientOptions(opts...),
+		),
+		reportPlaytime: connect.NewClient[v1.ReportPlaytimeRequest, v1.ReportPlaytimeResponse](
+			httpClient,
+			baseURL+LibraryServiceReportPlaytimeProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ReportPlaytime")),
+			connect.WithClientOptions(opts...),
+		),
+		listPlaytime: connect.NewClient[v1.ListPlaytimeRequest, v1.ListPlaytimeResponse](
+			httpClient,
+			baseURL+LibraryServiceListPlaytimeProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ListPlaytime")),
+			connect.WithClientOptions(opts...),
+		),
 		getGame: connect.NewClient[v1.GetGameRequest, v1.GetGameResponse](
 			httpClient,
 			baseURL+LibraryServiceGetGameProcedure,
 			connect.WithSchema(libraryServiceMethods.ByName("GetGame")),
 			connect.WithClientOptions(opts...),
 		),
+		listCollection: connect.NewClient[v1.ListCollectionRequest, v1.ListCollectionResponse](
+			httpClient,
+			baseURL+LibraryServiceListCollectionProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ListCollection")),
+			connect.WithClientOptions(opts...),
+		),
+		setCollection: connect.NewClient[v1.SetCollectionRequest, v1.SetCollectionResponse](
+			httpClient,
+			baseURL+LibraryServiceSetCollectionProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("SetCollection")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccess: connect.NewClient[v1.GetAccessRequest, v1.GetAccessResponse](
+			httpClient,
+			baseURL+LibraryServiceGetAccessProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("GetAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		setAccess: connect.NewClient[v1.SetAccessRequest, v1.SetAccessResponse](
+			httpClient,
+			baseURL+LibraryServiceSetAccessProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("SetAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		requestGame: connect.NewClient[v1.RequestGameRequest, v1.RequestGameResponse](
+			httpClient,
+			baseURL+LibraryServiceRequestGameProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("RequestGame")),
+			connect.WithClientOptions(opts...),
+		),
+		listRequests: connect.NewClient[v1.ListRequestsRequest, v1.ListRequestsResponse](
+			httpClient,
+			baseURL+LibraryServiceListRequestsProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ListRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		approveRequest: connect.NewClient[v1.ApproveRequestRequest, v1.ApproveRequestResponse](
+			httpClient,
+			baseURL+LibraryServiceApproveRequestProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("ApproveRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		denyRequest: connect.NewClient[v1.DenyRequestRequest, v1.DenyRequestResponse](
+			httpClient,
+			baseURL+LibraryServiceDenyRequestProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("DenyRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelRequest: connect.NewClient[v1.CancelRequestRequest, v1.CancelRequestResponse](
+			httpClient,
+			baseURL+LibraryServiceCancelRequestProcedure,
+			connect.WithSchema(libraryServiceMethods.ByName("CancelRequest")),
+			connect.WithClientOptions(opts...),
+		),
 		add: connect.NewClient[v1.AddRequest, v1.AddResponse](
 			httpClient,
 			baseURL+LibraryServiceAddProcedure,
@@ -125,7 +254,19 @@ type libraryServiceClient struct {
 	delete         *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
 	exists         *connect.Client[v1.ExistsRequest, v1.ExistsResponse]
 	triggerTracker *connect.Client[v1.TriggerTrackerRequest, v1.TriggerTrackerResponse]
+	watchDownloads *connect.Client[v1.WatchDownloadsRequest, v1.WatchDownloadsResponse]
+	reportPlaytime *connect.Client[v1.ReportPlaytimeRequest, v1.ReportPlaytimeResponse]
+	listPlaytime   *connect.Client[v1.ListPlaytimeRequest, v1.ListPlaytimeResponse]
 	getGame        *connect.Client[v1.GetGameRequest, v1.GetGameResponse]
+	listCollection *connect.Client[v1.ListCollectionRequest, v1.ListCollectionResponse]
+	setCollection  *connect.Client[v1.SetCollectionRequest, v1.SetCollectionResponse]
+	getAccess      *connect.Client[v1.GetAccessRequest, v1.GetAccessResponse]
+	setAccess      *connect.Client[v1.SetAccessRequest, v1.SetAccessResponse]
+	requestGame    *connect.Client[v1.RequestGameRequest, v1.RequestGameResponse]
+	listRequests   *connect.Client[v1.ListRequestsRequest, v1.ListRequestsResponse]
+	approveRequest *connect.Client[v1.ApproveRequestRequest, v1.ApproveRequestResponse]
+	denyRequest    *connect.Client[v1.DenyRequestRequest, v1.DenyRequestResponse]
+	cancelRequest  *connect.Client[v1.CancelRequestRequest, v1.CancelRequestResponse]
 	add            *connect.Client[v1.AddRequest, v1.AddResponse]
 }
 
@@ -154,11 +295,71 @@ func (c *libraryServiceClient) TriggerTracker(ctx context.Context, req *connect.
 	return c.triggerTracker.CallUnary(ctx, req)
 }
 
+// WatchDownloads calls library.v1.LibraryService.WatchDownloads.
+func (c *libraryServiceClient) WatchDownloads(ctx context.Context, req *connect.Request[v1.WatchDownloadsRequest]) (*connect.ServerStreamForClient[v1.WatchDownloadsResponse], error) {
+	return c.watchDownloads.CallServerStream(ctx, req)
+}
+
+// ReportPlaytime calls library.v1.LibraryService.ReportPlaytime.
+func (c *libraryServiceClient) ReportPlaytime(ctx context.Context, req *connect.Request[v1.ReportPlaytimeRequest]) (*connect.Response[v1.ReportPlaytimeResponse], error) {
+	return c.reportPlaytime.CallUnary(ctx, req)
+}
+
+// ListPlaytime calls library.v1.LibraryService.ListPlaytime.
+func (c *libraryServiceClient) ListPlaytime(ctx context.Context, req *connect.Request[v1.ListPlaytimeRequest]) (*connect.Response[v1.ListPlaytimeResponse], error) {
+	return c.listPlaytime.CallUnary(ctx, req)
+}
+
 // GetGame calls library.v1.LibraryService.GetGame.
 func (c *libraryServiceClient) GetGame(ctx context.Context, req *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error) {
 	return c.getGame.CallUnary(ctx, req)
 }
 
+// ListCollection calls library.v1.LibraryService.ListCollection.
+func (c *libraryServiceClient) ListCollection(ctx context.Context, req *connect.Request[v1.ListCollectionRequest]) (*connect.Response[v1.ListCollectionResponse], error) {
+	return c.listCollection.CallUnary(ctx, req)
+}
+
+// SetCollection calls library.v1.LibraryService.SetCollection.
+func (c *libraryServiceClient) SetCollection(ctx context.Context, req *connect.Request[v1.SetCollectionRequest]) (*connect.Response[v1.SetCollectionResponse], error) {
+	return c.setCollection.CallUnary(ctx, req)
+}
+
+// GetAccess calls library.v1.LibraryService.GetAccess.
+func (c *libraryServiceClient) GetAccess(ctx context.Context, req *connect.Request[v1.GetAccessRequest]) (*connect.Response[v1.GetAccessResponse], error) {
+	return c.getAccess.CallUnary(ctx, req)
+}
+
+// SetAccess calls library.v1.LibraryService.SetAccess.
+func (c *libraryServiceClient) SetAccess(ctx context.Context, req *connect.Request[v1.SetAccessRequest]) (*connect.Response[v1.SetAccessResponse], error) {
+	return c.setAccess.CallUnary(ctx, req)
+}
+
+// RequestGame calls library.v1.LibraryService.RequestGame.
+func (c *libraryServiceClient) RequestGame(ctx context.Context, req *connect.Request[v1.RequestGameRequest]) (*connect.Response[v1.RequestGameResponse], error) {
+	return c.requestGame.CallUnary(ctx, req)
+}
+
+// ListRequests calls library.v1.LibraryService.ListRequests.
+func (c *libraryServiceClient) ListRequests(ctx context.Context, req *connect.Request[v1.ListRequestsRequest]) (*connect.Response[v1.ListRequestsResponse], error) {
+	return c.listRequests.CallUnary(ctx, req)
+}
+
+// ApproveRequest calls library.v1.LibraryService.ApproveRequest.
+func (c *libraryServiceClient) ApproveRequest(ctx context.Context, req *connect.Request[v1.ApproveRequestRequest]) (*connect.Response[v1.ApproveRequestResponse], error) {
+	return c.approveRequest.CallUnary(ctx, req)
+}
+
+// DenyRequest calls library.v1.LibraryService.DenyRequest.
+func (c *libraryServiceClient) DenyRequest(ctx context.Context, req *connect.Request[v1.DenyRequestRequest]) (*connect.Response[v1.DenyRequestResponse], error) {
+	return c.denyRequest.CallUnary(ctx, req)
+}
+
+// CancelRequest calls library.v1.LibraryService.CancelRequest.
+func (c *libraryServiceClient) CancelRequest(ctx context.Context, req *connect.Request[v1.CancelRequestRequest]) (*connect.Response[v1.CancelRequestResponse], error) {
+	return c.cancelRequest.CallUnary(ctx, req)
+}
+
 // Add calls library.v1.LibraryService.Add.
 func (c *libraryServiceClient) Add(ctx context.Context, req *connect.Request[v1.AddRequest]) (*connect.Response[v1.AddResponse], error) {
 	return c.add.CallUnary(ctx, req)
@@ -171,7 +372,28 @@ type LibraryServiceHandler interface {
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
 	Exists(context.Context, *connect.Request[v1.ExistsRequest]) (*connect.Response[v1.ExistsResponse], error)
 	TriggerTracker(context.Context, *connect.Request[v1.TriggerTrackerRequest]) (*connect.Response[v1.TriggerTrackerResponse], error)
+	// streams the download state of games as it changes
+	WatchDownloads(context.Context, *connect.Request[v1.WatchDownloadsRequest], *connect.ServerStream[v1.WatchDownloadsResponse]) error
+	// adds a play session from frost to the playtime of the user
+	ReportPlaytime(context.Context, *connect.Request[v1.ReportPlaytimeRequest]) (*connect.Response[v1.ReportPlaytimeResponse], error)
+	ListPlaytime(context.Context, *connect.Request[v1.ListPlaytimeRequest]) (*connect.Response[v1.ListPlaytimeResponse], error)
 	GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error)
+	// owned and hidden flags of the games in the collection of the user
+	ListCollection(context.Context, *connect.Request[v1.ListCollectionRequest]) (*connect.Response[v1.ListCollectionResponse], error)
+	SetCollection(context.Context, *connect.Request[v1.SetCollectionRequest]) (*connect.Response[v1.SetCollectionResponse], error)
+	// users and roles that can see a private game
+	GetAccess(context.Context, *connect.Request[v1.GetAccessRequest]) (*connect.Response[v1.GetAccessResponse], error)
+	// only admins and the user who requested the game can change who sees it
+	SetAccess(context.Context, *connect.Request[v1.SetAccessRequest]) (*connect.Response[v1.SetAccessResponse], error)
+	// asks for a game to be added, users with requests:manage approve or deny it
+	RequestGame(context.Context, *connect.Request[v1.RequestGameRequest]) (*connect.Response[v1.RequestGameResponse], error)
+	// requests of the user, every request with all set
+	ListRequests(context.Context, *connect.Request[v1.ListRequestsRequest]) (*connect.Response[v1.ListRequestsResponse], error)
+	// adds the game with the source and starts the download
+	ApproveRequest(context.Context, *connect.Request[v1.ApproveRequestRequest]) (*connect.Response[v1.ApproveRequestResponse], error)
+	DenyRequest(context.Context, *connect.Request[v1.DenyRequestRequest]) (*connect.Response[v1.DenyRequestResponse], error)
+	// deletes a pending request
+	CancelRequest(context.Context, *connect.Request[v1.CancelRequestRequest]) (*connect.Response[v1.CancelRequestResponse], error)
 	Add(context.Context, *connect.Request[v1.AddRequest]) (*connect.Response[v1.AddResponse], error)
 }
 
@@ -212,12 +434,84 @@ func NewLibraryServiceHandler(svc LibraryServiceHandler, opts ...connect.Handler
 		connect.WithSchema(libraryServiceMethods.ByName("TriggerTracker")),
 		connect.WithHandlerOptions(opts...),
 	)
+	libraryServiceWatchDownloadsHandler := connect.NewServerStreamHandler(
+		LibraryServiceWatchDownloadsProcedure,
+		svc.WatchDownloads,
+		connect.WithSchema(libraryServiceMethods.ByName("WatchDownloads")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceReportPlaytimeHandler := connect.NewUnaryHandler(
+		LibraryServiceReportPlaytimeProcedure,
+		svc.ReportPlaytime,
+		connect.WithSchema(libraryServiceMethods.ByName("ReportPlaytime")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceListPlaytimeHandler := connect.NewUnaryHandler(
+		LibraryServiceListPlaytimeProcedure,
+		svc.ListPlaytime,
+		connect.WithSchema(libraryServiceMethods.ByName("ListPlaytime")),
+		connect.WithHandlerOptions(opts...),
+	)
 	libraryServiceGetGameHandler := connect.NewUnaryHandler(
 		LibraryServiceGetGameProcedure,
 		svc.GetGame,
 		connect.WithSchema(libraryServiceMethods.ByName("GetGame")),
 		connect.WithHandlerOptions(opts...),
 	)
+	libraryServiceListCollectionHandler := connect.NewUnaryHandler(
+		LibraryServiceListCollectionProcedure,
+		svc.ListCollection,
+		connect.WithSchema(libraryServiceMethods.ByName("ListCollection")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceSetCollectionHandler := connect.NewUnaryHandler(
+		LibraryServiceSetCollectionProcedure,
+		svc.SetCollection,
+		connect.WithSchema(libraryServiceMethods.ByName("SetCollection")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceGetAccessHandler := connect.NewUnaryHandler(
+		LibraryServiceGetAccessProcedure,
+		svc.GetAccess,
+		connect.WithSchema(libraryServiceMethods.ByName("GetAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceSetAccessHandler := connect.NewUnaryHandler(
+		LibraryServiceSetAccessProcedure,
+		svc.SetAccess,
+		connect.WithSchema(libraryServiceMethods.ByName("SetAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceRequestGameHandler := connect.NewUnaryHandler(
+		LibraryServiceRequestGameProcedure,
+		svc.RequestGame,
+		connect.WithSchema(libraryServiceMethods.ByName("RequestGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceListRequestsHandler := connect.NewUnaryHandler(
+		LibraryServiceListRequestsProcedure,
+		svc.ListRequests,
+		connect.WithSchema(libraryServiceMethods.ByName("ListRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceApproveRequestHandler := connect.NewUnaryHandler(
+		LibraryServiceApproveRequestProcedure,
+		svc.ApproveRequest,
+		connect.WithSchema(libraryServiceMethods.ByName("ApproveRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceDenyRequestHandler := connect.NewUnaryHandler(
+		LibraryServiceDenyRequestProcedure,
+		svc.DenyRequest,
+		connect.WithSchema(libraryServiceMethods.ByName("DenyRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryServiceCancelRequestHandler := connect.NewUnaryHandler(
+		LibraryServiceCancelRequestProcedure,
+		svc.CancelRequest,
+		connect.WithSchema(libraryServiceMethods.ByName("CancelRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
 	libraryServiceAddHandler := connect.NewUnaryHandler(
 		LibraryServiceAddProcedure,
 		svc.Add,
@@ -236,8 +530,32 @@ func NewLibraryServiceHandler(svc LibraryServiceHandler, opts ...connect.Handler
 			libraryServiceExistsHandler.ServeHTTP(w, r)
 		case LibraryServiceTriggerTrackerProcedure:
 			libraryServiceTriggerTrackerHandler.ServeHTTP(w, r)
+		case LibraryServiceWatchDownloadsProcedure:
+			libraryServiceWatchDownloadsHandler.ServeHTTP(w, r)
+		case LibraryServiceReportPlaytimeProcedure:
+			libraryServiceReportPlaytimeHandler.ServeHTTP(w, r)
+		case LibraryServiceListPlaytimeProcedure:
+			libraryServiceListPlaytimeHandler.ServeHTTP(w, r)
 		case LibraryServiceGetGameProcedure:
 			libraryServiceGetGameHandler.ServeHTTP(w, r)
+		case LibraryServiceListCollectionProcedure:
+			libraryServiceListCollectionHandler.ServeHTTP(w, r)
+		case LibraryServiceSetCollectionProcedure:
+			libraryServiceSetCollectionHandler.ServeHTTP(w, r)
+		case LibraryServiceGetAccessProcedure:
+			libraryServiceGetAccessHandler.ServeHTTP(w, r)
+		case LibraryServiceSetAccessProcedure:
+			libraryServiceSetAccessHandler.ServeHTTP(w, r)
+		case LibraryServiceRequestGameProcedure:
+			libraryServiceRequestGameHandler.ServeHTTP(w, r)
+		case LibraryServiceListRequestsProcedure:
+			libraryServiceListRequestsHandler.ServeHTTP(w, r)
+		case LibraryServiceApproveRequestProcedure:
+			libraryServiceApproveRequestHandler.ServeHTTP(w, r)
+		case LibraryServiceDenyRequestProcedure:
+			libraryServiceDenyRequestHandler.ServeHTTP(w, r)
+		case LibraryServiceCancelRequestProcedure:
+			libraryServiceCancelRequestHandler.ServeHTTP(w, r)
 		case LibraryServiceAddProcedure:
 			libraryServiceAddHandler.ServeHTTP(w, r)
 		default:
@@ -269,10 +587,58 @@ func (UnimplementedLibraryServiceHandler) TriggerTracker(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.TriggerTracker is not implemented"))
 }
 
+func (UnimplementedLibraryServiceHandler) WatchDownloads(context.Context, *connect.Request[v1.WatchDownloadsRequest], *connect.ServerStream[v1.WatchDownloadsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.WatchDownloads is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) ReportPlaytime(context.Context, *connect.Request[v1.ReportPlaytimeRequest]) (*connect.Response[v1.ReportPlaytimeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.ReportPlaytime is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) ListPlaytime(context.Context, *connect.Request[v1.ListPlaytimeRequest]) (*connect.Response[v1.ListPlaytimeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.ListPlaytime is not implemented"))
+}
+
 func (UnimplementedLibraryServiceHandler) GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.GetGame is not implemented"))
 }
 
+func (UnimplementedLibraryServiceHandler) ListCollection(context.Context, *connect.Request[v1.ListCollectionRequest]) (*connect.Response[v1.ListCollectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.ListCollection is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) SetCollection(context.Context, *connect.Request[v1.SetCollectionRequest]) (*connect.Response[v1.SetCollectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.SetCollection is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) GetAccess(context.Context, *connect.Request[v1.GetAccessRequest]) (*connect.Response[v1.GetAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.GetAccess is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) SetAccess(context.Context, *connect.Request[v1.SetAccessRequest]) (*connect.Response[v1.SetAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.SetAccess is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) RequestGame(context.Context, *connect.Request[v1.RequestGameRequest]) (*connect.Response[v1.RequestGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.RequestGame is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) ListRequests(context.Context, *connect.Request[v1.ListRequestsRequest]) (*connect.Response[v1.ListRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.ListRequests is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) ApproveRequest(context.Context, *connect.Request[v1.ApproveRequestRequest]) (*connect.Response[v1.ApproveRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.ApproveRequest is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) DenyRequest(context.Context, *connect.Request[v1.DenyRequestRequest]) (*connect.Response[v1.DenyRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.DenyRequest is not implemented"))
+}
+
+func (UnimplementedLibraryServiceHandler) CancelRequest(context.Context, *connect.Request[v1.CancelRequestRequest]) (*connect.Response[v1.CancelRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.CancelRequest is not implemented"))
+}
+
 func (UnimplementedLibraryServiceHandler) Add(context.Context, *connect.Request[v1.AddRequest]) (*connect.Response[v1.AddResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("library.v1.LibraryService.Add is not implemented"))
 }
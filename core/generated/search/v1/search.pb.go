@@ -74,8 +74,11 @@ func (x *Query) GetIndexer() string {
 }
 
 type SearchIndexersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Q             *Query                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Q      *Query                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	Offset uint32                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 0 returns every result
+	Limit         uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,9 +120,25 @@ func (x *SearchIndexersRequest) GetQ() *Query {
 	return nil
 }
 
+func (x *SearchIndexersRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchIndexersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 type SearchIndexersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*GameSource          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*GameSource          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// number of results before pagination
+	Total         uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -161,6 +180,13 @@ func (x *SearchIndexersResponse) GetResults() []*GameSource {
 	return nil
 }
 
+func (x *SearchIndexersResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GameSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IndexerType   string                 `protobuf:"bytes,6,opt,name=IndexerType,proto3" json:"IndexerType,omitempty"`
@@ -170,6 +196,9 @@ type GameSource struct {
 	ImageURL      string                 `protobuf:"bytes,3,opt,name=ImageURL,proto3" json:"ImageURL,omitempty"`
 	FileSize      string                 `protobuf:"bytes,4,opt,name=FileSize,proto3" json:"FileSize,omitempty"`
 	CreatedISO    string                 `protobuf:"bytes,5,opt,name=CreatedISO,proto3" json:"CreatedISO,omitempty"`
+	SourceName    string                 `protobuf:"bytes,8,opt,name=SourceName,proto3" json:"SourceName,omitempty"`
+	Uris          []string               `protobuf:"bytes,9,rep,name=Uris,proto3" json:"Uris,omitempty"`
+	Seeders       uint32                 `protobuf:"varint,10,opt,name=Seeders,proto3" json:"Seeders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -253,6 +282,27 @@ func (x *GameSource) GetCreatedISO() string {
 	return ""
 }
 
+func (x *GameSource) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *GameSource) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *GameSource) GetSeeders() uint32 {
+	if x != nil {
+		return x.Seeders
+	}
+	return 0
+}
+
 type SearchMetadataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Q             *Query                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
@@ -504,11 +554,14 @@ const file_search_v1_search_proto_rawDesc = "" +
 	"\x16search/v1/search.proto\x12\tsearch.v1\"7\n" +
 	"\x05Query\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x18\n" +
-	"\aindexer\x18\x02 \x01(\tR\aindexer\"7\n" +
+	"\aindexer\x18\x02 \x01(\tR\aindexer\"e\n" +
 	"\x15SearchIndexersRequest\x12\x1e\n" +
-	"\x01q\x18\x01 \x01(\v2\x10.search.v1.QueryR\x01q\"I\n" +
+	"\x01q\x18\x01 \x01(\v2\x10.search.v1.QueryR\x01q\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\rR\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"_\n" +
 	"\x16SearchIndexersResponse\x12/\n" +
-	"\aresults\x18\x01 \x03(\v2\x15.search.v1.GameSourceR\aresults\"\xda\x01\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.search.v1.GameSourceR\aresults\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xa8\x02\n" +
 	"\n" +
 	"GameSource\x12 \n" +
 	"\vIndexerType\x18\x06 \x01(\tR\vIndexerType\x12\x1a\n" +
@@ -519,7 +572,13 @@ const file_search_v1_search_proto_rawDesc = "" +
 	"\bFileSize\x18\x04 \x01(\tR\bFileSize\x12\x1e\n" +
 	"\n" +
 	"CreatedISO\x18\x05 \x01(\tR\n" +
-	"CreatedISO\"7\n" +
+	"CreatedISO\x12\x1e\n" +
+	"\n" +
+	"SourceName\x18\b \x01(\tR\n" +
+	"SourceName\x12\x12\n" +
+	"\x04Uris\x18\t \x03(\tR\x04Uris\x12\x18\n" +
+	"\aSeeders\x18\n" +
+	" \x01(\rR\aSeeders\"7\n" +
 	"\x15SearchMetadataRequest\x12\x1e\n" +
 	"\x01q\x18\x01 \x01(\v2\x10.search.v1.QueryR\x01q\"M\n" +
 	"\x16SearchMetadataResponse\x123\n" +
@@ -45,6 +45,12 @@ const (
 	UserServiceListRolesProcedure = "/user.v1.UserService/ListRoles"
 	// UserServiceSelfProcedure is the fully-qualified name of the UserService's Self RPC.
 	UserServiceSelfProcedure = "/user.v1.UserService/Self"
+	// UserServiceListPermissionsProcedure is the fully-qualified name of the UserService's
+	// ListPermissions RPC.
+	UserServiceListPermissionsProcedure = "/user.v1.UserService/ListPermissions"
+	// UserServiceSetRolePermissionsProcedure is the fully-qualified name of the UserService's
+	// SetRolePermissions RPC.
+	UserServiceSetRolePermissionsProcedure = "/user.v1.UserService/SetRolePermissions"
 )
 
 // UserServiceClient is a client for the user.v1.UserService service.
@@ -55,6 +61,8 @@ type UserServiceClient interface {
 	Edit(context.Context, *connect.Request[v1.EditRequest]) (*connect.Response[v1.EditResponse], error)
 	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
 	Self(context.Context, *connect.Request[v1.SelfRequest]) (*connect.Response[v1.SelfResponse], error)
+	ListPermissions(context.Context, *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error)
+	SetRolePermissions(context.Context, *connect.Request[v1.SetRolePermissionsRequest]) (*connect.Response[v1.SetRolePermissionsResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
@@ -104,17 +112,31 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("Self")),
 			connect.WithClientOptions(opts...),
 		),
+		listPermissions: connect.NewClient[v1.ListPermissionsRequest, v1.ListPermissionsResponse](
+			httpClient,
+			baseURL+UserServiceListPermissionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListPermissions")),
+			connect.WithClientOptions(opts...),
+		),
+		setRolePermissions: connect.NewClient[v1.SetRolePermissionsRequest, v1.SetRolePermissionsResponse](
+			httpClient,
+			baseURL+UserServiceSetRolePermissionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetRolePermissions")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	list      *connect.Client[v1.ListRequest, v1.ListResponse]
-	delete    *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
-	new       *connect.Client[v1.NewRequest, v1.NewResponse]
-	edit      *connect.Client[v1.EditRequest, v1.EditResponse]
-	listRoles *connect.Client[v1.ListRolesRequest, v1.ListRolesResponse]
-	self      *connect.Client[v1.SelfRequest, v1.SelfResponse]
+	list               *connect.Client[v1.ListRequest, v1.ListResponse]
+	delete             *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
+	new                *connect.Client[v1.NewRequest, v1.NewResponse]
+	edit               *connect.Client[v1.EditRequest, v1.EditResponse]
+	listRoles          *connect.Client[v1.ListRolesRequest, v1.ListRolesResponse]
+	self               *connect.Client[v1.SelfRequest, v1.SelfResponse]
+	listPermissions    *connect.Client[v1.ListPermissionsRequest, v1.ListPermissionsResponse]
+	setRolePermissions *connect.Client[v1.SetRolePermissionsRequest, v1.SetRolePermissionsResponse]
 }
 
 // List calls user.v1.UserService.List.
@@ -147,6 +169,16 @@ func (c *userServiceClient) Self(ctx context.Context, req *connect.Request[v1.Se
 	return c.self.CallUnary(ctx, req)
 }
 
+// ListPermissions calls user.v1.UserService.ListPermissions.
+func (c *userServiceClient) ListPermissions(ctx context.Context, req *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error) {
+	return c.listPermissions.CallUnary(ctx, req)
+}
+
+// SetRolePermissions calls user.v1.UserService.SetRolePermissions.
+func (c *userServiceClient) SetRolePermissions(ctx context.Context, req *connect.Request[v1.SetRolePermissionsRequest]) (*connect.Response[v1.SetRolePermissionsResponse], error) {
+	return c.setRolePermissions.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.v1.UserService service.
 type UserServiceHandler interface {
 	List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error)
@@ -155,6 +187,8 @@ type UserServiceHandler interface {
 	Edit(context.Context, *connect.Request[v1.EditRequest]) (*connect.Response[v1.EditResponse], error)
 	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
 	Self(context.Context, *connect.Request[v1.SelfRequest]) (*connect.Response[v1.SelfResponse], error)
+	ListPermissions(context.Context, *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error)
+	SetRolePermissions(context.Context, *connect.Request[v1.SetRolePermissionsRequest]) (*connect.Response[v1.SetRolePermissionsResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -200,6 +234,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("Self")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListPermissionsHandler := connect.NewUnaryHandler(
+		UserServiceListPermissionsProcedure,
+		svc.ListPermissions,
+		connect.WithSchema(userServiceMethods.ByName("ListPermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetRolePermissionsHandler := connect.NewUnaryHandler(
+		UserServiceSetRolePermissionsProcedure,
+		svc.SetRolePermissions,
+		connect.WithSchema(userServiceMethods.ByName("SetRolePermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceListProcedure:
@@ -214,6 +260,10 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceListRolesHandler.ServeHTTP(w, r)
 		case UserServiceSelfProcedure:
 			userServiceSelfHandler.ServeHTTP(w, r)
+		case UserServiceListPermissionsProcedure:
+			userServiceListPermissionsHandler.ServeHTTP(w, r)
+		case UserServiceSetRolePermissionsProcedure:
+			userServiceSetRolePermissionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -246,3 +296,11 @@ func (UnimplementedUserServiceHandler) ListRoles(context.Context, *connect.Reque
 func (UnimplementedUserServiceHandler) Self(context.Context, *connect.Request[v1.SelfRequest]) (*connect.Response[v1.SelfResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.Self is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) ListPermissions(context.Context, *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListPermissions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetRolePermissions(context.Context, *connect.Request[v1.SetRolePermissionsRequest]) (*connect.Response[v1.SetRolePermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.SetRolePermissions is not implemented"))
+}
@@ -72,6 +72,9 @@ func NewApp() *App {
 	downSrv.StartTracker() // check for previous incomplete downloads
 
 	libSrv := library.New(libDb, fms,
+		library.NewStorePlaytimeGorm(db),
+		library.NewStoreCollectionGorm(db),
+		library.NewStoreRequestGorm(db),
 		downSrv,
 		func() *library.Config {
 			return &c.Library
@@ -89,6 +92,7 @@ func NewApp() *App {
 	sessionDb := auth.NewStoreGorm(db, c.Auth.MaxConcurrentSessions)
 	sessionSrv := auth.New(
 		sessionDb,
+		auth.NewStoreTokenGorm(db),
 		userSrv,
 		func() *auth.Config {
 			return &c.Auth
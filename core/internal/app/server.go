@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	smconnect "github.com/ra341/glacier/generated/service_config/v1/v1connect"
 	"github.com/ra341/glacier/internal/auth"
 	"github.com/ra341/glacier/internal/indexer"
 	"github.com/ra341/glacier/internal/library"
@@ -125,9 +126,15 @@ func (s *Server) registerProtectedRoutes(mux *http.ServeMux) {
 	)
 
 	mux.Handle(user.NewHandler(s.User))
-
-	adminMiddleware := NewMiddleware(user.AdminMiddleware)
-	mux.Handle(adminMiddleware(sm.NewHandler(s.ConfigManager)))
+	mux.Handle(auth.NewHandlerToken(s.Session))
+	mux.Handle(auth.NewHandlerSession(s.Session))
+
+	servicesMiddleware := NewMiddleware(user.PermissionMiddleware(
+		user.PermServicesManage,
+		// frost and the ui check which services are active
+		smconnect.ServiceConfigServiceGetActiveServiceProcedure,
+	))
+	mux.Handle(servicesMiddleware(sm.NewHandler(s.ConfigManager)))
 }
 
 type NewHandler func(string, http.Handler) (string, http.Handler)
@@ -1,6 +1,9 @@
 package auth
 
-import "time"
+import (
+	"net/netip"
+	"time"
+)
 
 const (
 	Day   = time.Hour * 24
@@ -17,6 +20,8 @@ type Config struct {
 	SessionExpiryInDays   int `yaml:"sessionExpiryInDays" env:"AUTH_SESSION_EXPIRY" default:"1" help:"time validity for a session"`
 	RefreshExpiryInMonths int `yaml:"refreshExpiryInMonths" env:"AUTH_REFRESH_EXPIRY" default:"12" help:"time validity for a refresh"`
 
+	TrustedProxies []string `yaml:"trustedProxies" env:"AUTH_TRUSTED_PROXIES" default:"none" help:"addresses or CIDRs in CSV of reverse proxies whose X-Forwarded-For header is used as the client ip, none trusts no proxy"`
+
 	OIDCEnable       bool   `yaml:"OIDCEnable" env:"AUTH_OIDC_ENABLE" default:"false" help:"enable OIDC support"`
 	OIDCIssuerURL    string `yaml:"OIDCIssuerURL" env:"AUTH_OIDC_ISSUER" default:"TODO" help:"url for your OIDC issuer"`
 	OIDCClientID     string `yaml:"OIDCClientID" env:"AUTH_OIDC_CLIENT_ID" default:"TODO" help:"client id for OIDC" hide:"true"`
@@ -29,5 +34,22 @@ func (c *Config) GetSessionExp() time.Duration {
 }
 
 func (c *Config) GetRefreshExp() time.Duration {
-	return Month * time.Duration(c.SessionExpiryInDays)
+	return Month * time.Duration(c.RefreshExpiryInMonths)
+}
+
+// IsTrustedProxy reports if the address is one of the trusted proxies
+func (c *Config) IsTrustedProxy(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, proxy := range c.TrustedProxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			if prefix.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if ip, err := netip.ParseAddr(proxy); err == nil && ip.Unmap() == addr {
+			return true
+		}
+	}
+	return false
 }
@@ -9,7 +9,6 @@ import (
 	"connectrpc.com/connect"
 	v1 "github.com/ra341/glacier/generated/auth/v1"
 	"github.com/ra341/glacier/generated/auth/v1/v1connect"
-	"github.com/ra341/glacier/internal/user"
 	"github.com/rs/zerolog/log"
 )
 
@@ -56,6 +55,7 @@ func (h *Handler) Login(ctx context.Context, req *connect.Request[v1.LoginReques
 			req.Msg.Username,
 			req.Msg.Password,
 			sessionT,
+			h.srv.ClientFromRequest(req.Peer().Addr, req.Header()),
 		)
 	}
 
@@ -76,7 +76,7 @@ func (h *Handler) Register(ctx context.Context, req *connect.Request[v1.Register
 		return nil, fmt.Errorf("password do not match")
 	}
 
-	err := h.srv.Register(req.Msg.Username, req.Msg.Password, user.TechPriest, nil)
+	err := h.srv.Register(req.Msg.Username, req.Msg.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -84,39 +84,22 @@ func (h *Handler) Register(ctx context.Context, req *connect.Request[v1.Register
 	return connect.NewResponse(&v1.RegisterResponse{}), nil
 }
 
+// Logout deletes the session on the server and clears the cookies of the web ui
 func (h *Handler) Logout(ctx context.Context, req *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
-	cookies := req.Header().Get("Cookie")
-	err := h.getSessionCookie(ctx, cookies)
-	if err != nil {
-		log.Warn().Err(err).Msg("logout err")
-	}
-
-	// always return success
-	return connect.NewResponse(&v1.LogoutResponse{}), nil
-}
+	resp := connect.NewResponse(&v1.LogoutResponse{})
 
-func (h *Handler) getSessionCookie(ctx context.Context, cookies string) error {
-	cookie, err := http.ParseCookie(cookies)
+	session, refresh := sessionTokens(req.Header())
+	err := h.srv.Logout(session, refresh)
 	if err != nil {
-		return fmt.Errorf("could not parse cookie %v", err)
-	}
-
-	ctx, err = checkAuthCookie(h.srv, cookie, ctx, nil)
-	if err != nil {
-		return fmt.Errorf("could not validate cookie %v", err)
-	}
-
-	sess, err := GetSessionCtx(ctx)
-	if err != nil {
-		return fmt.Errorf("could not get session %v", err)
+		log.Warn().Err(err).Msg("logout err")
 	}
 
-	err = h.srv.store.Delete(sess)
-	if err != nil {
-		return fmt.Errorf("could not delete session %v", err)
+	if req.Header().Get(FrostReqHeader) != "true" {
+		clearWebCookie(resp)
 	}
 
-	return nil
+	// always return success
+	return resp, nil
 }
 
 const HeaderFrostRefreshToken = "frostRefreshKey"
@@ -151,6 +134,12 @@ func writeWebCookie(hw HeaderWriter, s *Session, session, refresh string) {
 	)
 }
 
+func clearWebCookie(hw HeaderWriter) {
+	expired := time.Unix(0, 0)
+	addCookie(hw, CookieSessionToken, "", expired)
+	addCookie(hw, CookieRefreshToken, "", expired)
+}
+
 type HeaderWriter interface {
 	Header() http.Header
 }
@@ -71,7 +71,7 @@ func (h *HandlerHttp) OIDCCallback(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	normalLogin := func(sessionT SessionType) (session Session, sessionToken string, refreshToken string, err error) {
-		return h.srv.LoginOIDC(ctx, code, sessionT)
+		return h.srv.LoginOIDC(ctx, code, sessionT, h.srv.ClientFromRequest(r.RemoteAddr, r.Header))
 	}
 
 	err = loginSessionHandler(
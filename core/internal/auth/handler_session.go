@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/ra341/glacier/generated/auth/v1"
+	"github.com/ra341/glacier/generated/auth/v1/v1connect"
+	"github.com/ra341/glacier/internal/user"
+)
+
+type HandlerSession struct {
+	srv *Service
+}
+
+func NewHandlerSession(srv *Service) (string, http.Handler) {
+	h := &HandlerSession{srv: srv}
+	return v1connect.NewSessionServiceHandler(h)
+}
+
+func (h *HandlerSession) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	u, err := user.Authorize(ctx, user.PermAccountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.srv.ListSessions(userIdOrSelf(req.Msg.UserId, u), u)
+	if err != nil {
+		return nil, err
+	}
+
+	currentId := currentSessionId(ctx)
+	res := make([]*v1.SessionInfo, 0, len(sessions))
+	for _, sess := range sessions {
+		info := sess.ToProto()
+		info.Current = sess.ID == currentId
+		res = append(res, info)
+	}
+
+	return connect.NewResponse(&v1.ListSessionsResponse{Sessions: res}), nil
+}
+
+func (h *HandlerSession) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	u, err := user.Authorize(ctx, user.PermAccountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.srv.RevokeSession(uint(req.Msg.Id), u)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.RevokeSessionResponse{}), nil
+}
+
+func (h *HandlerSession) RevokeUserSessions(ctx context.Context, req *connect.Request[v1.RevokeUserSessionsRequest]) (*connect.Response[v1.RevokeUserSessionsResponse], error) {
+	u, err := user.Authorize(ctx, user.PermAccountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	var keepId uint
+	if req.Msg.KeepCurrent {
+		keepId = currentSessionId(ctx)
+	}
+
+	err = h.srv.RevokeUserSessions(userIdOrSelf(req.Msg.UserId, u), keepId, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.RevokeUserSessionsResponse{}), nil
+}
+
+func userIdOrSelf(userId uint64, u *user.User) uint {
+	if userId == 0 {
+		return u.ID
+	}
+	return uint(userId)
+}
+
+// currentSessionId is 0 for requests made with an api token
+func currentSessionId(ctx context.Context) uint {
+	sess, err := GetSessionCtx(ctx)
+	if err != nil {
+		return 0
+	}
+	return sess.ID
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/ra341/glacier/generated/auth/v1"
+	"github.com/ra341/glacier/generated/auth/v1/v1connect"
+	"github.com/ra341/glacier/internal/user"
+	"github.com/ra341/glacier/pkg/listutils"
+)
+
+type HandlerToken struct {
+	srv *Service
+}
+
+func NewHandlerToken(srv *Service) (string, http.Handler) {
+	h := &HandlerToken{srv: srv}
+	return v1connect.NewTokenServiceHandler(h)
+}
+
+func (h *HandlerToken) CreateToken(ctx context.Context, req *connect.Request[v1.CreateTokenRequest]) (*connect.Response[v1.CreateTokenResponse], error) {
+	// a leaked token should not be able to create more tokens
+	if _, ok := GetApiTokenCtx(ctx); ok {
+		return nil, fmt.Errorf("api tokens cannot create tokens, log in instead")
+	}
+
+	u, err := user.Authorize(ctx, user.PermAccountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := listutils.ToMapErr(req.Msg.Scopes, user.PermissionString)
+	if err != nil {
+		return nil, err
+	}
+
+	token, plain, err := h.srv.CreateToken(
+		u,
+		req.Msg.Name,
+		scopes,
+		Day*time.Duration(req.Msg.ExpiresInDays),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CreateTokenResponse{
+		Token:      token.ToProto(),
+		PlainToken: plain,
+	}), nil
+}
+
+func (h *HandlerToken) ListTokens(ctx context.Context, req *connect.Request[v1.ListTokensRequest]) (*connect.Response[v1.ListTokensResponse], error) {
+	u, err := user.Authorize(ctx, user.PermAccountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := h.srv.ListTokens(u)
+	if err != nil {
+		return nil, err
+	}
+
+	res := listutils.ToMap(tokens, func(t ApiToken) *v1.ApiToken {
+		return t.ToProto()
+	})
+	return connect.NewResponse(&v1.ListTokensResponse{Tokens: res}), nil
+}
+
+func (h *HandlerToken) RevokeToken(ctx context.Context, req *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error) {
+	u, err := user.Authorize(ctx, user.PermAccountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.srv.RevokeToken(uint(req.Msg.Id), u)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.RevokeTokenResponse{}), nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/ra341/glacier/internal/user"
@@ -23,10 +24,15 @@ func NewMiddleware(srv *Service, next http.Handler) http.Handler {
 		var ctx context.Context
 		var err error
 
-		if r.Header.Get(FrostReqHeader) == "true" {
-			ctx, err = checkFrostHeaders(srv, r.Header, r.Context(), w)
+		if token, ok := bearerToken(r.Header); ok {
+			ctx, err = checkApiToken(srv, token, r.Context())
 		} else {
-			ctx, err = checkAuthCookie(srv, r.Cookies(), r.Context(), w)
+			session, refresh := sessionTokens(r.Header)
+			ctx, err = verifySession(r.Context(), srv, session, refresh, w)
+			if err == nil {
+				sess, _ := GetSessionCtx(ctx)
+				srv.SessionSeen(sess, srv.ClientFromRequest(r.RemoteAddr, r.Header))
+			}
 		}
 
 		if err != nil {
@@ -45,16 +51,35 @@ func NewMiddleware(srv *Service, next http.Handler) http.Handler {
 	})
 }
 
-func checkFrostHeaders(srv *Service, headers http.Header, ctx context.Context, hw http.ResponseWriter) (retCtx context.Context, err error) {
-	session := headers.Get(HeaderFrostSessionToken)
-	refresh := headers.Get(HeaderFrostRefreshToken)
+// bearerToken gets the api token of the Authorization header
+func bearerToken(headers http.Header) (string, bool) {
+	authHeader := headers.Get("Authorization")
+	scheme, token, found := strings.Cut(authHeader, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func checkApiToken(srv *Service, plain string, ctx context.Context) (context.Context, error) {
+	token, err := srv.VerifyToken(plain)
+	if err != nil {
+		log.Debug().Err(err).Msg("invalid api token")
+		return ctx, ErrInvalidToken
+	}
 
-	return verifySession(ctx, srv, session, refresh, hw)
+	ctx = context.WithValue(ctx, CtxKeyApiToken, &token)
+	ctx = context.WithValue(ctx, user.CtxKeyUser, &token.User)
+	return ctx, nil
 }
 
-func checkAuthCookie(srv *Service, cookies []*http.Cookie, ctx context.Context, headerW HeaderWriter) (context.Context, error) {
-	session := ""
-	refresh := ""
+// sessionTokens gets the session and refresh token of frost headers or web cookies
+func sessionTokens(headers http.Header) (session string, refresh string) {
+	if headers.Get(FrostReqHeader) == "true" {
+		return headers.Get(HeaderFrostSessionToken), headers.Get(HeaderFrostRefreshToken)
+	}
+
+	cookies := (&http.Request{Header: headers}).Cookies()
 	for _, s := range cookies {
 		if s.Name == CookieSessionToken {
 			session = s.Value
@@ -63,8 +88,7 @@ func checkAuthCookie(srv *Service, cookies []*http.Cookie, ctx context.Context,
 			refresh = s.Value
 		}
 	}
-
-	return verifySession(ctx, srv, session, refresh, headerW)
+	return session, refresh
 }
 
 func verifySession(ctx context.Context, srv *Service, sessionToken string, refreshToken string, hw HeaderWriter) (context.Context, error) {
@@ -116,8 +140,10 @@ func verifySession(ctx context.Context, srv *Service, sessionToken string, refre
 }
 
 var ErrInvalidSession = errors.New("authentication failed no valid session or refresh token")
+var ErrInvalidToken = errors.New("authentication failed invalid or expired api token")
 
 const CtxKeySession = "session-inf"
+const CtxKeyApiToken = "api-token-inf"
 
 func injectSession(ctx context.Context, s *Session) context.Context {
 	ctx = context.WithValue(ctx, CtxKeySession, s)
@@ -137,3 +163,9 @@ func GetSessionCtx(ctx context.Context) (*Session, error) {
 	}
 	return u, nil
 }
+
+// GetApiTokenCtx returns the api token of requests authenticated with one
+func GetApiTokenCtx(ctx context.Context) (*ApiToken, bool) {
+	token, ok := ctx.Value(CtxKeyApiToken).(*ApiToken)
+	return token, ok
+}
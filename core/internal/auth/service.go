@@ -19,6 +19,7 @@ import (
 
 type Service struct {
 	store   Store
+	tokens  StoreToken
 	userSrv *user.Service
 	conf    ConfigLoader
 
@@ -33,9 +34,10 @@ var (
 	ErrInvalidUserPass    = errors.New("invalid username/password")
 )
 
-func New(store Store, userSrv *user.Service, conf ConfigLoader) *Service {
+func New(store Store, tokens StoreToken, userSrv *user.Service, conf ConfigLoader) *Service {
 	s := &Service{
 		store:   store,
+		tokens:  tokens,
 		userSrv: userSrv,
 		conf:    conf,
 	}
@@ -64,18 +66,20 @@ func New(store Store, userSrv *user.Service, conf ConfigLoader) *Service {
 	return s
 }
 
-func (s *Service) Register(username, password string, role user.Role, creatingUser *user.User) (err error) {
-	if creatingUser == nil && !s.conf().OpenRegistration {
+// Register signs up a new user when open registration is enabled,
+// users with users:manage create other users through the user service instead
+func (s *Service) Register(username, password string) (err error) {
+	if !s.conf().OpenRegistration {
 		return ErrRegistrationClosed
 	}
-	err = s.userSrv.New(username, password, role, creatingUser)
+	err = s.userSrv.Register(username, password)
 	if err != nil && errors.Is(err, gorm.ErrDuplicatedKey) {
 		return ErrDuplicateUser
 	}
 	return err
 }
 
-func (s *Service) Login(username, password string, sessionType SessionType) (session Session, sessionToken string, refreshToken string, err error) {
+func (s *Service) Login(username, password string, sessionType SessionType, client ClientInfo) (session Session, sessionToken string, refreshToken string, err error) {
 	u, err := s.userSrv.GetByUsername(username)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get user by username")
@@ -88,13 +92,16 @@ func (s *Service) Login(username, password string, sessionType SessionType) (ses
 		return Session{}, "", "", ErrInvalidUserPass
 	}
 
-	return s.createSession(&u, sessionType)
+	return s.createSession(&u, sessionType, client)
 }
 
-func (s *Service) createSession(u *user.User, sessionType SessionType) (session Session, sessionToken string, refreshToken string, err error) {
+func (s *Service) createSession(u *user.User, sessionType SessionType, client ClientInfo) (session Session, sessionToken string, refreshToken string, err error) {
 	var sess Session
 	sess.SessionType = sessionType
 	sess.UserId = u.ID
+	sess.LastSeen = time.Now()
+	sess.IP = client.IP
+	sess.UserAgent = client.UserAgent
 	sessionToken, refreshToken = s.GenerateTok(&sess)
 
 	err = s.store.New(&sess)
@@ -136,6 +143,7 @@ func (s *Service) LoginOIDC(
 	ctx context.Context,
 	code string,
 	sessionType SessionType,
+	client ClientInfo,
 ) (session Session, sessionToken string, refreshToken string, err error) {
 	ctx = getOidcContext(ctx)
 	oauth2Token, err := s.oauthConfig.Exchange(ctx, code)
@@ -170,7 +178,7 @@ func (s *Service) LoginOIDC(
 		return Session{}, "", "", fmt.Errorf("failed to get user by email: %w", err)
 	}
 
-	return s.createSession(&u, sessionType)
+	return s.createSession(&u, sessionType, client)
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -208,6 +216,7 @@ func (s *Service) VerifySession(sessionToken string) (session Session, err error
 		return token, err
 	}
 
+	s.userSrv.WithPermissions(&token.User)
 	return token, nil
 }
 
@@ -223,13 +232,14 @@ func (s *Service) RefreshSession(refreshToken string) (session Session, sessionT
 		return Session{}, "", "", err
 	}
 
-	sessionTok, refreshTok = s.GenerateTok(&session)
-	err = s.store.Edit(&session)
+	sessionTok, refreshTok = s.GenerateTok(&sess)
+	err = s.store.Edit(&sess)
 	if err != nil {
 		return Session{}, "", "", err
 	}
 
-	return session, sessionTok, refreshTok, nil
+	s.userSrv.WithPermissions(&sess.User)
+	return sess, sessionTok, refreshTok, nil
 }
 
 func checkExpiry(expiry time.Time) error {
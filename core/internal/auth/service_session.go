@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/ra341/glacier/internal/user"
+
+	"github.com/rs/zerolog/log"
+)
+
+// lastSeenInterval limits how often the last seen time of a session is saved
+const lastSeenInterval = time.Minute
+
+// ClientInfo is the client a session was last used from
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// ClientFromRequest gets the client of a request,
+// X-Forwarded-For is only used when the request comes through a trusted proxy
+func (s *Service) ClientFromRequest(remoteAddr string, headers http.Header) ClientInfo {
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+
+	// proxies append the address they got the request from,
+	// the client is the last address that is not a trusted proxy
+	conf := s.conf()
+	forwarded := strings.Split(headers.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil || !conf.IsTrustedProxy(addr) {
+			break
+		}
+
+		next := strings.TrimSpace(forwarded[i])
+		if next == "" {
+			break
+		}
+		ip = next
+	}
+
+	return ClientInfo{
+		IP:        ip,
+		UserAgent: headers.Get("User-Agent"),
+	}
+}
+
+// SessionSeen saves the time and client of a request made with the session
+func (s *Service) SessionSeen(sess *Session, client ClientInfo) {
+	now := time.Now()
+	if now.Sub(sess.LastSeen) < lastSeenInterval &&
+		sess.IP == client.IP &&
+		sess.UserAgent == client.UserAgent {
+		return
+	}
+
+	sess.LastSeen = now
+	sess.IP = client.IP
+	sess.UserAgent = client.UserAgent
+	err := s.store.Touch(sess)
+	if err != nil {
+		log.Warn().Err(err).Uint("session", sess.ID).Msg("could not save session last seen")
+	}
+}
+
+// Logout deletes the session of the tokens, expired tokens are accepted
+func (s *Service) Logout(sessionToken string, refreshToken string) error {
+	if sessionToken != "" {
+		sess, err := s.store.GetBySessionToken(user.HashString(sessionToken))
+		if err == nil {
+			return s.store.Delete(&sess)
+		}
+	}
+
+	if refreshToken != "" {
+		sess, err := s.store.GetByRefreshToken(user.HashString(refreshToken))
+		if err == nil {
+			return s.store.Delete(&sess)
+		}
+	}
+
+	return ErrInvalidSession
+}
+
+// ListSessions lists the sessions of a user, users with users:manage can list users with the same or a lower role
+func (s *Service) ListSessions(userId uint, u *user.User) ([]Session, error) {
+	err := s.canManageUser(userId, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.ListByUser(userId)
+}
+
+// RevokeSession deletes a session, users with users:manage can revoke the sessions of users with the same or a lower role
+func (s *Service) RevokeSession(id uint, u *user.User) error {
+	sess, err := s.store.GetBySessionId(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.canManageUser(sess.UserId, u)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(&sess)
+}
+
+// RevokeUserSessions deletes every session of the user except keepId, 0 keeps none
+func (s *Service) RevokeUserSessions(userId uint, keepId uint, u *user.User) error {
+	err := s.canManageUser(userId, u)
+	if err != nil {
+		return err
+	}
+
+	return s.store.DeleteByUser(userId, keepId)
+}
+
+// canManageUser allows users to manage their own sessions and tokens,
+// users with users:manage can manage users with the same or a lower role
+func (s *Service) canManageUser(userId uint, u *user.User) error {
+	if userId == u.ID {
+		return nil
+	}
+	if !u.Can(user.PermUsersManage) {
+		return fmt.Errorf("%w: %s is needed to manage other users", user.ErrPermissionDenied, user.PermUsersManage)
+	}
+
+	target, err := s.userSrv.GetByID(userId)
+	if err != nil {
+		return err
+	}
+	if target.Role < u.Role {
+		return fmt.Errorf("%w: cannot manage user with higher role", user.ErrPermissionDenied)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ra341/glacier/internal/database"
+	"github.com/ra341/glacier/internal/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessions(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	userStore := user.NewStoreGorm(db)
+	userSrv := user.NewService(userStore)
+	srv := New(NewStoreGorm(db, 2), NewStoreTokenGorm(db), userSrv, testConfig(&Config{}))
+
+	admin, err := userSrv.GetByID(user.DefaultUserId)
+	require.NoError(t, err)
+	userSrv.WithPermissions(&admin)
+
+	password, err := user.EncryptPassword("priest")
+	require.NoError(t, err)
+	priest := user.User{Username: "priest", Email: "priest@glacier", EncryptedPassword: password, Role: user.TechPriest}
+	require.NoError(t, userStore.New(&priest))
+	userSrv.WithPermissions(&priest)
+
+	client := ClientInfo{IP: "10.0.0.1", UserAgent: "test"}
+	first, firstTok, _, err := srv.Login(user.DefaultUser, user.DefaultPassword, Web, client)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", first.IP)
+	_, _, _, err = srv.Login(user.DefaultUser, user.DefaultPassword, Web, client)
+	require.NoError(t, err)
+
+	// the oldest session is evicted instead of failing
+	_, thirdTok, thirdRefresh, err := srv.Login(user.DefaultUser, user.DefaultPassword, Web, client)
+	require.NoError(t, err)
+	sessions, err := srv.ListSessions(admin.ID, &admin)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	_, err = srv.VerifySession(firstTok)
+	require.Error(t, err)
+
+	// requests update the last seen client
+	handler := NewMiddleware(srv, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.2:4000"
+	req.Header.Set("User-Agent", "frost")
+	req.AddCookie(&http.Cookie{Name: CookieSessionToken, Value: thirdTok})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	third, err := srv.VerifySession(thirdTok)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.2", third.IP)
+	require.Equal(t, "frost", third.UserAgent)
+
+	// only admins see and revoke sessions of other users
+	_, err = srv.ListSessions(admin.ID, &priest)
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+	require.ErrorIs(t, srv.RevokeSession(third.ID, &priest), user.ErrPermissionDenied)
+
+	_, priestTok, _, err := srv.Login("priest", "priest", Frost, client)
+	require.NoError(t, err)
+	require.NoError(t, srv.RevokeUserSessions(priest.ID, 0, &admin))
+	_, err = srv.VerifySession(priestTok)
+	require.Error(t, err)
+
+	// admins cannot manage sessions of users with a higher role
+	password, err = user.EncryptPassword("magos")
+	require.NoError(t, err)
+	magos := user.User{Username: "magos", Email: "magos@glacier", EncryptedPassword: password, Role: user.Magos}
+	require.NoError(t, userStore.New(&magos))
+	userSrv.WithPermissions(&magos)
+
+	_, err = srv.ListSessions(admin.ID, &magos)
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+	require.ErrorIs(t, srv.RevokeSession(third.ID, &magos), user.ErrPermissionDenied)
+	require.ErrorIs(t, srv.RevokeUserSessions(admin.ID, 0, &magos), user.ErrPermissionDenied)
+	_, err = srv.VerifySession(thirdTok)
+	require.NoError(t, err)
+
+	_, priestTok, _, err = srv.Login("priest", "priest", Frost, client)
+	require.NoError(t, err)
+	require.NoError(t, srv.RevokeUserSessions(priest.ID, 0, &magos))
+	_, err = srv.VerifySession(priestTok)
+	require.Error(t, err)
+
+	// logout works with only the refresh token
+	require.NoError(t, srv.Logout("", thirdRefresh))
+	_, err = srv.VerifySession(thirdTok)
+	require.Error(t, err)
+	require.ErrorIs(t, srv.Logout("", thirdRefresh), ErrInvalidSession)
+
+	sessions, err = srv.ListSessions(admin.ID, &admin)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.NoError(t, srv.RevokeUserSessions(admin.ID, sessions[0].ID, &admin))
+	sessions, err = srv.ListSessions(admin.ID, &admin)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+}
+
+func TestClientFromRequest(t *testing.T) {
+	conf := &Config{TrustedProxies: []string{"none"}}
+	srv := New(nil, nil, nil, testConfig(conf))
+
+	headers := http.Header{}
+	headers.Set("X-Forwarded-For", "1.1.1.1, 10.0.0.5")
+
+	// the header is ignored unless the request comes from a trusted proxy
+	require.Equal(t, "192.168.1.10", srv.ClientFromRequest("192.168.1.10:4000", headers).IP)
+
+	conf.TrustedProxies = []string{"192.168.1.10", "10.0.0.0/8"}
+	require.Equal(t, "1.1.1.1", srv.ClientFromRequest("192.168.1.10:4000", headers).IP)
+	require.Equal(t, "192.168.1.11", srv.ClientFromRequest("192.168.1.11:4000", headers).IP)
+
+	// addresses added by the client before the proxy are not trusted
+	headers.Set("X-Forwarded-For", "6.6.6.6, 1.1.1.1")
+	require.Equal(t, "1.1.1.1", srv.ClientFromRequest("192.168.1.10:4000", headers).IP)
+	require.Equal(t, "192.168.1.10", srv.ClientFromRequest("192.168.1.10:4000", http.Header{}).IP)
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
@@ -16,59 +17,68 @@ func TestService_RoleChecks(t *testing.T) {
 	uts := &TestUserStore{}
 	us := user.NewService(uts)
 	ts := &TestSessionStore{}
-	srv := New(ts, us, false)
+	srv := New(ts, nil, us, testConfig(&Config{}))
 
 	u, err := srv.userSrv.GetByUsername(user.DefaultUser)
 	require.NoError(t, err)
+	us.WithPermissions(&u)
 
 	use := "new"
 	p := "new"
 
-	err = srv.Register(use, p, user.Magos, &u)
+	err = us.New(userCtx(&u), use, p, user.Magos)
 	require.NoError(t, err)
 
 	adminUser, err := uts.GetByUsername(use)
 	require.NoError(t, err)
+	us.WithPermissions(&adminUser)
 
 	use = "new22"
 	p = "new333"
-	err = srv.Register(use, p, user.Omnissiah, &u)
+	err = us.New(userCtx(&u), use, p, user.Omnissiah)
 	require.NoError(t, err)
 
-	err = srv.Register(use, p, user.Omnissiah, &adminUser)
+	err = us.New(userCtx(&adminUser), use, p, user.Omnissiah)
 	require.Error(t, err)
 
-	err = srv.Register(use, p, user.TechPriest, &adminUser)
+	err = us.New(userCtx(&adminUser), use, p, user.TechPriest)
 	require.NoError(t, err)
 }
 
+func userCtx(u *user.User) context.Context {
+	return context.WithValue(context.Background(), user.CtxKeyUser, u)
+}
+
 func TestService_Register(t *testing.T) {
 	uts := &TestUserStore{}
 	us := user.NewService(uts)
 	ts := &TestSessionStore{}
-	srv := New(ts, us, false)
+	conf := &Config{}
+	srv := New(ts, nil, us, testConfig(conf))
 
 	u := "test"
 	p := "test"
 
-	err := srv.Register(u, p, user.Magos, nil)
+	err := srv.Register(u, p)
 	require.Error(t, err)
 
-	srv.openRegistration = true
-	err = srv.Register(u, p, user.Magos, nil)
+	conf.OpenRegistration = true
+	err = srv.Register(u, p)
 	require.NoError(t, err)
 
 	usd, err := uts.GetByUsername(u)
 	require.NoError(t, err)
 	require.Equal(t, usd.Role, user.TechPriest)
 
-	expectedSess, session, refresh, err := srv.Login(u, p, Web)
+	expectedSess, session, refresh, err := srv.Login(u, p, Web, ClientInfo{})
 	require.NoError(t, err)
 
 	t.Log(session, refresh)
 
 	verifySession, err := srv.VerifySession(session)
 	require.NoError(t, err)
+	// permissions of the user are loaded on verify
+	us.WithPermissions(&expectedSess.User)
 	require.Equal(t, expectedSess, verifySession, "session changed between login and verify")
 
 	// invalidate session
@@ -79,20 +89,30 @@ func TestService_Register(t *testing.T) {
 	verifySession, err = srv.VerifySession(session)
 	require.ErrorIs(t, err, ErrTokenExpired, "token should be expired")
 
-	_, session, _, err = srv.RefreshSession(&expectedSess)
+	refreshed, session, refresh, err := srv.RefreshSession(refresh)
 	require.NoError(t, err)
+	require.Equal(t, expectedSess.ID, refreshed.ID, "refresh should update the same session")
 
 	_, err = srv.VerifySession(session)
 	require.NoError(t, err)
 
-	expectedSess.RefreshTokenExpiry = time.Now().Add(-time.Second * 5)
-	err = ts.Edit(&expectedSess)
+	refreshed.RefreshTokenExpiry = time.Now().Add(-time.Second * 5)
+	err = ts.Edit(&refreshed)
 	require.NoError(t, err)
 
-	_, session, _, err = srv.RefreshSession(&expectedSess)
+	_, session, _, err = srv.RefreshSession(refresh)
 	require.ErrorIs(t, err, ErrTokenExpired, "token should be expired")
 }
 
+// testConfig keeps sessions valid for a day and refresh tokens for a month
+func testConfig(conf *Config) ConfigLoader {
+	conf.SessionExpiryInDays = 1
+	conf.RefreshExpiryInMonths = 1
+	return func() *Config {
+		return conf
+	}
+}
+
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 // test user
 type TestUserStore struct {
@@ -145,7 +165,32 @@ func (t *TestUserStore) Delete(id uint) error {
 	return nil
 }
 
-func (t *TestUserStore) List() ([]user.User, error) {
+func (t *TestUserStore) GetByEmail(email string) (user.User, error) {
+	var found user.User
+	var ok bool
+	t.store.Range(func(key uint, value user.User) bool {
+		if value.Email == email {
+			found = value
+			ok = true
+			return false
+		}
+		return true
+	})
+	if !ok {
+		return user.User{}, gorm.ErrRecordNotFound
+	}
+	return found, nil
+}
+
+func (t *TestUserStore) ListRolePermissions() ([]user.RolePermissions, error) {
+	return nil, nil
+}
+
+func (t *TestUserStore) SetRolePermissions(perms *user.RolePermissions) error {
+	return nil
+}
+
+func (t *TestUserStore) List(q string) ([]user.User, error) {
 	var users []user.User
 	t.store.Range(func(key uint, value user.User) bool {
 		users = append(users, value)
@@ -163,8 +208,34 @@ type TestSessionStore struct {
 }
 
 func (t *TestSessionStore) GetByRefreshToken(token string) (Session, error) {
-	//TODO implement me
-	panic("implement me")
+	var found Session
+	var ok bool
+	t.store.Range(func(key uint, value Session) bool {
+		if value.HashedRefreshToken == token {
+			found = value
+			ok = true
+			return false
+		}
+		return true
+	})
+	if !ok {
+		return Session{}, errors.New("session not found")
+	}
+	return found, nil
+}
+
+func (t *TestSessionStore) DeleteByUser(userId uint, keepId uint) error {
+	t.store.Range(func(key uint, value Session) bool {
+		if value.UserId == userId && key != keepId {
+			t.store.Delete(key)
+		}
+		return true
+	})
+	return nil
+}
+
+func (t *TestSessionStore) Touch(session *Session) error {
+	return t.Edit(session)
 }
 
 func (t *TestSessionStore) New(session *Session) error {
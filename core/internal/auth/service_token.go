@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ra341/glacier/internal/user"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ApiTokenPrefix marks api tokens so they are easy to find in scripts and secret scanners
+const ApiTokenPrefix = "glc_"
+
+// lastUsedInterval limits how often the last used time of a token is saved
+const lastUsedInterval = time.Minute
+
+// CreateToken creates an api token for the user, scopes can only be permissions the user has,
+// a 0 expiry never expires, the plain token is only returned here
+func (s *Service) CreateToken(u *user.User, name string, scopes []user.Permission, expiry time.Duration) (ApiToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ApiToken{}, "", fmt.Errorf("token name is required")
+	}
+	if expiry < 0 {
+		return ApiToken{}, "", fmt.Errorf("token expiry cannot be negative")
+	}
+	for _, scope := range scopes {
+		if !u.Can(scope) {
+			return ApiToken{}, "", fmt.Errorf("%w: cannot give the token %s", user.ErrPermissionDenied, scope)
+		}
+	}
+
+	plain := ApiTokenPrefix + user.GenerateRandomToken(40)
+	token := ApiToken{
+		UserId:      u.ID,
+		Name:        name,
+		HashedToken: user.HashString(plain),
+		Prefix:      plain[:len(ApiTokenPrefix)+4],
+		Scopes:      slices.Compact(slices.Sorted(slices.Values(scopes))),
+	}
+	if expiry > 0 {
+		expiresAt := time.Now().Add(expiry)
+		token.ExpiresAt = &expiresAt
+	}
+
+	err := s.tokens.NewToken(&token)
+	if err != nil {
+		return ApiToken{}, "", err
+	}
+
+	return token, plain, nil
+}
+
+func (s *Service) ListTokens(u *user.User) ([]ApiToken, error) {
+	return s.tokens.ListTokens(u.ID)
+}
+
+// RevokeToken deletes a token of the user, users with users:manage can revoke the tokens of users with the same or a lower role
+func (s *Service) RevokeToken(id uint, u *user.User) error {
+	token, err := s.tokens.GetToken(id)
+	if err != nil {
+		return err
+	}
+	err = s.canManageUser(token.UserId, u)
+	if err != nil {
+		return err
+	}
+
+	return s.tokens.DeleteToken(id)
+}
+
+// VerifyToken returns the token with its user,
+// the user only gets the permissions of its role that are also in the token scopes
+func (s *Service) VerifyToken(plain string) (ApiToken, error) {
+	token, err := s.tokens.GetByHashedToken(user.HashString(plain))
+	if err != nil {
+		return ApiToken{}, err
+	}
+
+	if token.ExpiresAt != nil {
+		err = checkExpiry(*token.ExpiresAt)
+		if err != nil {
+			return ApiToken{}, err
+		}
+	}
+
+	s.userSrv.WithPermissions(&token.User)
+	token.User.Permissions = slices.DeleteFunc(token.User.Permissions, func(p user.Permission) bool {
+		return !slices.Contains(token.Scopes, p)
+	})
+
+	now := time.Now()
+	if token.LastUsed == nil || now.Sub(*token.LastUsed) > lastUsedInterval {
+		err = s.tokens.TouchToken(token.ID, now)
+		if err != nil {
+			log.Warn().Err(err).Uint("token", token.ID).Msg("could not save token last used")
+		}
+		token.LastUsed = &now
+	}
+
+	return token, nil
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/ra341/glacier/generated/auth/v1"
+	"github.com/ra341/glacier/internal/database"
+	"github.com/ra341/glacier/internal/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiToken(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	userStore := user.NewStoreGorm(db)
+	userSrv := user.NewService(userStore)
+	srv := New(NewStoreGorm(db, 8), NewStoreTokenGorm(db), userSrv, func() *Config {
+		return &Config{}
+	})
+
+	admin, err := userSrv.GetByID(user.DefaultUserId)
+	require.NoError(t, err)
+	userSrv.WithPermissions(&admin)
+
+	_, _, err = srv.CreateToken(&admin, " ", nil, 0)
+	require.Error(t, err)
+	_, _, err = srv.CreateToken(&admin, "negative", nil, -time.Hour)
+	require.Error(t, err)
+
+	token, plain, err := srv.CreateToken(&admin, "ci", []user.Permission{user.PermLibraryAdd}, 0)
+	require.NoError(t, err)
+	require.Nil(t, token.ExpiresAt)
+	require.Nil(t, token.LastUsed)
+
+	var seen *user.User
+	handler := NewMiddleware(srv, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen, err = user.GetUserCtx(r.Context())
+		require.NoError(t, err)
+	}))
+	call := func(header string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", header)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// only the scopes of the token are allowed
+	require.Equal(t, http.StatusOK, call("Bearer "+plain))
+	require.Equal(t, admin.ID, seen.ID)
+	require.Equal(t, []user.Permission{user.PermLibraryAdd}, seen.Permissions)
+
+	require.Equal(t, http.StatusUnauthorized, call("Bearer "+plain+"x"))
+
+	tokens, err := srv.ListTokens(&admin)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, "ci", tokens[0].Name)
+	require.NotNil(t, tokens[0].LastUsed)
+
+	// scopes cannot go past the permissions of the user
+	priest := &user.User{Role: user.TechPriest}
+	priest.ID = 2
+	userSrv.WithPermissions(priest)
+	_, _, err = srv.CreateToken(priest, "bad", []user.Permission{user.PermLibraryDelete}, 0)
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+	require.ErrorIs(t, srv.RevokeToken(token.ID, priest), user.ErrPermissionDenied)
+
+	expired, expiredPlain, err := srv.CreateToken(&admin, "old", nil, time.Hour)
+	require.NoError(t, err)
+	past := time.Now().Add(-time.Minute)
+	require.NoError(t, db.Model(&expired).Update("expires_at", past).Error)
+	_, err = srv.VerifyToken(expiredPlain)
+	require.ErrorIs(t, err, ErrTokenExpired)
+
+	// users:manage only reaches tokens of the same or a lower role
+	magos := user.User{Username: "magos", Email: "magos@glacier", Role: user.Magos}
+	require.NoError(t, userStore.New(&magos))
+	userSrv.WithPermissions(&magos)
+	magosToken, _, err := srv.CreateToken(&magos, "magos", nil, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, srv.RevokeToken(token.ID, &magos), user.ErrPermissionDenied)
+	require.NoError(t, srv.RevokeToken(magosToken.ID, &admin))
+
+	require.NoError(t, srv.RevokeToken(token.ID, &admin))
+	require.Equal(t, http.StatusUnauthorized, call("Bearer "+plain))
+
+	_, ok := GetApiTokenCtx(context.Background())
+	require.False(t, ok)
+}
+
+func TestApiTokenAccount(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	userSrv := user.NewService(user.NewStoreGorm(db))
+	srv := New(NewStoreGorm(db, 8), NewStoreTokenGorm(db), userSrv, testConfig(&Config{}))
+
+	admin, err := userSrv.GetByID(user.DefaultUserId)
+	require.NoError(t, err)
+	userSrv.WithPermissions(&admin)
+
+	var editErr error
+	handler := NewMiddleware(srv, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		editErr = userSrv.Edit(r.Context(), &user.User{
+			Model:             admin.Model,
+			Role:              admin.Role,
+			EncryptedPassword: "changed",
+		})
+	}))
+	changePassword := func(plain string) error {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+plain)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		return editErr
+	}
+
+	// tokens cannot take over the account without the account scope
+	_, scoped, err := srv.CreateToken(&admin, "scoped", []user.Permission{user.PermLibraryAdd, user.PermUsersManage}, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, changePassword(scoped), user.ErrPermissionDenied)
+	_, _, _, err = srv.Login(user.DefaultUser, user.DefaultPassword, Web, ClientInfo{})
+	require.NoError(t, err)
+
+	tokenCtx := func(plain string) context.Context {
+		token, err := srv.VerifyToken(plain)
+		require.NoError(t, err)
+		return context.WithValue(context.Background(), user.CtxKeyUser, &token.User)
+	}
+	tokens := &HandlerToken{srv: srv}
+	_, err = tokens.ListTokens(tokenCtx(scoped), connect.NewRequest(&v1.ListTokensRequest{}))
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+	sessions := &HandlerSession{srv: srv}
+	_, err = sessions.ListSessions(tokenCtx(scoped), connect.NewRequest(&v1.ListSessionsRequest{}))
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+
+	_, account, err := srv.CreateToken(&admin, "account", []user.Permission{user.PermAccountManage}, 0)
+	require.NoError(t, err)
+	require.NoError(t, changePassword(account))
+	_, _, _, err = srv.Login(user.DefaultUser, "changed", Web, ClientInfo{})
+	require.NoError(t, err)
+}
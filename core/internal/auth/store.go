@@ -11,6 +11,10 @@ type Store interface {
 	New(token *Session) error
 	Edit(token *Session) error
 	Delete(token *Session) error
+	// DeleteByUser deletes every session of the user except keepId, 0 keeps none
+	DeleteByUser(userId uint, keepId uint) error
+	// Touch saves the last seen time and client of the session
+	Touch(session *Session) error
 
 	List() ([]Session, error)
 	ListByUser(userId uint) ([]Session, error)
@@ -33,6 +37,11 @@ type Session struct {
 	SessionTokenExpiry time.Time
 
 	SessionType SessionType
+
+	// last request made with the session
+	LastSeen  time.Time
+	IP        string
+	UserAgent string
 }
 
 //go:generate go run github.com/dmarkham/enumer@latest -sql -type=SessionType -output=enum_session_type.go
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -24,7 +25,10 @@ func (s *StoreGorm) List() ([]Session, error) {
 
 func (s *StoreGorm) ListByUser(userId uint) ([]Session, error) {
 	var sessions []Session
-	err := s.db.Where("user_id = ?", userId).Find(&sessions).Error
+	err := s.db.Where("user_id = ?", userId).
+		Order("last_seen DESC").
+		Find(&sessions).
+		Error
 	return sessions, err
 }
 
@@ -52,32 +56,37 @@ func (s *StoreGorm) GetByRefreshToken(token string) (Session, error) {
 	return session, err
 }
 
+// New saves the session, when the user is at the max sessions of the type
+// the sessions that were seen the longest time ago are evicted
 func (s *StoreGorm) New(token *Session) error {
 	return s.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Create(token).Error; err != nil {
-			return err
-		}
+		if s.maxSessions > 0 {
+			var ids []uint
+			err := tx.Model(&Session{}).
+				Where("user_id = ? AND session_type = ?", token.UserId, token.SessionType).
+				Order("last_seen DESC, updated_at DESC").
+				Pluck("id", &ids).
+				Error
+			if err != nil {
+				return err
+			}
+
+			// leave room for the new session
+			if len(ids) >= s.maxSessions {
+				evict := ids[s.maxSessions-1:]
+				log.Debug().
+					Uint("user", token.UserId).
+					Int("count", len(evict)).
+					Msg("evicting oldest sessions")
 
-		var idsToKeep []string
-		err := tx.Model(&Session{}).
-			Select("id").
-			Where("user_id = ? AND session_type = ?", token.UserId, token.SessionType).
-			// use update instead of created to ensure a refreshed session is not deleted
-			Order("updated_at DESC").
-			Limit(s.maxSessions).
-			Pluck("id", &idsToKeep).
-			Error
-		if err != nil {
-			return err
+				err = tx.Unscoped().Delete(&Session{}, evict).Error
+				if err != nil {
+					return err
+				}
+			}
 		}
 
-		// delete everything else for this user and this specific session type
-		return tx.
-			Where("user_id = ?", token.UserId).
-			Where("session_type = ?", token.SessionType).
-			Where("id NOT IN ?", idsToKeep).
-			Unscoped().Delete(&Session{}).
-			Error
+		return tx.Create(token).Error
 	})
 }
 
@@ -88,3 +97,22 @@ func (s *StoreGorm) Edit(token *Session) error {
 func (s *StoreGorm) Delete(token *Session) error {
 	return s.db.Unscoped().Delete(token).Error
 }
+
+func (s *StoreGorm) DeleteByUser(userId uint, keepId uint) error {
+	return s.db.
+		Where("user_id = ?", userId).
+		Where("id <> ?", keepId).
+		Unscoped().Delete(&Session{}).
+		Error
+}
+
+func (s *StoreGorm) Touch(session *Session) error {
+	return s.db.Model(&Session{}).
+		Where("id = ?", session.ID).
+		UpdateColumns(map[string]any{
+			"last_seen":  session.LastSeen,
+			"ip":         session.IP,
+			"user_agent": session.UserAgent,
+		}).
+		Error
+}
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"time"
+
+	v1 "github.com/ra341/glacier/generated/auth/v1"
+)
+
+func (s *Session) ToProto() *v1.SessionInfo {
+	return &v1.SessionInfo{
+		Id:        uint64(s.ID),
+		Type:      s.SessionType.String(),
+		CreatedAt: s.CreatedAt.Format(time.RFC3339),
+		LastSeen:  s.LastSeen.Format(time.RFC3339),
+		Ip:        s.IP,
+		UserAgent: s.UserAgent,
+	}
+}
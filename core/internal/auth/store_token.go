@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/ra341/glacier/internal/user"
+	"gorm.io/gorm"
+)
+
+type StoreToken interface {
+	NewToken(token *ApiToken) error
+	DeleteToken(id uint) error
+	// TouchToken sets the last time the token was used
+	TouchToken(id uint, lastUsed time.Time) error
+
+	ListTokens(userId uint) ([]ApiToken, error)
+	GetToken(id uint) (ApiToken, error)
+	GetByHashedToken(token string) (ApiToken, error)
+}
+
+// ApiToken is a long-lived personal access token for scripts,
+// it can only do what both its scopes and the role of its user allow
+type ApiToken struct {
+	gorm.Model
+
+	UserId uint      `gorm:"index"`
+	User   user.User `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	Name        string
+	HashedToken string `gorm:"uniqueIndex"`
+	// first characters of the token to tell tokens apart
+	Prefix string
+	Scopes []user.Permission `gorm:"serializer:json"`
+
+	// nil for tokens that never expire
+	ExpiresAt *time.Time
+	LastUsed  *time.Time
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type StoreTokenGorm struct {
+	db *gorm.DB
+}
+
+func NewStoreTokenGorm(db *gorm.DB) *StoreTokenGorm {
+	return &StoreTokenGorm{db: db}
+}
+
+func (s *StoreTokenGorm) NewToken(token *ApiToken) error {
+	return s.db.Create(token).Error
+}
+
+func (s *StoreTokenGorm) DeleteToken(id uint) error {
+	return s.db.Unscoped().Delete(&ApiToken{}, id).Error
+}
+
+func (s *StoreTokenGorm) TouchToken(id uint, lastUsed time.Time) error {
+	return s.db.Model(&ApiToken{}).
+		Where("id = ?", id).
+		// keep updated_at for changes made by the user
+		UpdateColumn("last_used", lastUsed).
+		Error
+}
+
+func (s *StoreTokenGorm) ListTokens(userId uint) ([]ApiToken, error) {
+	var tokens []ApiToken
+	err := s.db.Where("user_id = ?", userId).
+		Order("created_at DESC").
+		Find(&tokens).
+		Error
+	return tokens, err
+}
+
+func (s *StoreTokenGorm) GetToken(id uint) (ApiToken, error) {
+	var token ApiToken
+	err := s.db.First(&token, id).Error
+	return token, err
+}
+
+func (s *StoreTokenGorm) GetByHashedToken(token string) (ApiToken, error) {
+	var apiToken ApiToken
+	err := s.db.Preload("User").
+		Where("hashed_token = ?", token).
+		First(&apiToken).
+		Error
+	return apiToken, err
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"time"
+
+	v1 "github.com/ra341/glacier/generated/auth/v1"
+	"github.com/ra341/glacier/internal/user"
+	"github.com/ra341/glacier/pkg/listutils"
+)
+
+func (t *ApiToken) ToProto() *v1.ApiToken {
+	res := &v1.ApiToken{
+		Id:     uint64(t.ID),
+		Name:   t.Name,
+		Prefix: t.Prefix,
+		Scopes: listutils.ToMap(t.Scopes, func(p user.Permission) string {
+			return string(p)
+		}),
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+	if t.ExpiresAt != nil {
+		res.ExpiresAt = t.ExpiresAt.Format(time.RFC3339)
+	}
+	if t.LastUsed != nil {
+		res.LastUsed = t.LastUsed.Format(time.RFC3339)
+	}
+	return res
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "extracted" to table: "games"
+ALTER TABLE `games` ADD COLUMN `extracted` integer NULL;
+-- add column "extract_total" to table: "games"
+ALTER TABLE `games` ADD COLUMN `extract_total` integer NULL;
+
+-- +goose Down
+-- reverse: add column "extract_total" to table: "games"
+ALTER TABLE `games` DROP COLUMN `extract_total`;
+-- reverse: add column "extracted" to table: "games"
+ALTER TABLE `games` DROP COLUMN `extracted`;
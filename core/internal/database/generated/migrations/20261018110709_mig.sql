@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "source_name" to table: "games"
+ALTER TABLE `games` ADD COLUMN `source_name` text NULL;
+-- add column "uris" to table: "games"
+ALTER TABLE `games` ADD COLUMN `uris` text NULL;
+
+-- +goose Down
+-- reverse: add column "uris" to table: "games"
+ALTER TABLE `games` DROP COLUMN `uris`;
+-- reverse: add column "source_name" to table: "games"
+ALTER TABLE `games` DROP COLUMN `source_name`;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "seeders" to table: "games"
+ALTER TABLE `games` ADD COLUMN `seeders` integer NULL;
+
+-- +goose Down
+-- reverse: add column "seeders" to table: "games"
+ALTER TABLE `games` DROP COLUMN `seeders`;
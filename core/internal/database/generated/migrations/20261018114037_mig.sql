@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "playtimes" table
+CREATE TABLE `playtimes` (`id` integer NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NULL, `updated_at` datetime NULL, `deleted_at` datetime NULL, `user_id` integer NULL, `game_id` integer NULL, `seconds` integer NULL, `sessions` integer NULL, `last_played` datetime NULL);
+-- create index "idx_playtime_user_game" to table: "playtimes"
+CREATE UNIQUE INDEX `idx_playtime_user_game` ON `playtimes` (`user_id`, `game_id`);
+-- create index "idx_playtimes_deleted_at" to table: "playtimes"
+CREATE INDEX `idx_playtimes_deleted_at` ON `playtimes` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_playtimes_deleted_at" to table: "playtimes"
+DROP INDEX `idx_playtimes_deleted_at`;
+-- reverse: create index "idx_playtime_user_game" to table: "playtimes"
+DROP INDEX `idx_playtime_user_game`;
+-- reverse: create "playtimes" table
+DROP TABLE `playtimes`;
@@ -0,0 +1,39 @@
+-- +goose Up
+-- add column "requested_by" to table: "games"
+ALTER TABLE `games` ADD COLUMN `requested_by` integer NULL;
+-- add column "visibility" to table: "games"
+ALTER TABLE `games` ADD COLUMN `visibility` text NULL;
+-- create index "idx_games_requested_by" to table: "games"
+CREATE INDEX `idx_games_requested_by` ON `games` (`requested_by`);
+-- create "user_games" table
+CREATE TABLE `user_games` (`id` integer NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NULL, `updated_at` datetime NULL, `deleted_at` datetime NULL, `user_id` integer NULL, `game_id` integer NULL, `owned` numeric NULL, `hidden` numeric NULL);
+-- create index "idx_user_game" to table: "user_games"
+CREATE UNIQUE INDEX `idx_user_game` ON `user_games` (`user_id`, `game_id`);
+-- create index "idx_user_games_deleted_at" to table: "user_games"
+CREATE INDEX `idx_user_games_deleted_at` ON `user_games` (`deleted_at`);
+-- create "game_accesses" table
+CREATE TABLE `game_accesses` (`id` integer NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NULL, `updated_at` datetime NULL, `deleted_at` datetime NULL, `game_id` integer NULL, `user_id` integer NULL, `role` text NULL, CONSTRAINT `fk_game_accesses_game` FOREIGN KEY (`game_id`) REFERENCES `games` (`id`) ON UPDATE CASCADE ON DELETE CASCADE);
+-- create index "idx_game_accesses_game_id" to table: "game_accesses"
+CREATE INDEX `idx_game_accesses_game_id` ON `game_accesses` (`game_id`);
+-- create index "idx_game_accesses_deleted_at" to table: "game_accesses"
+CREATE INDEX `idx_game_accesses_deleted_at` ON `game_accesses` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_game_accesses_deleted_at" to table: "game_accesses"
+DROP INDEX `idx_game_accesses_deleted_at`;
+-- reverse: create index "idx_game_accesses_game_id" to table: "game_accesses"
+DROP INDEX `idx_game_accesses_game_id`;
+-- reverse: create "game_accesses" table
+DROP TABLE `game_accesses`;
+-- reverse: create index "idx_user_games_deleted_at" to table: "user_games"
+DROP INDEX `idx_user_games_deleted_at`;
+-- reverse: create index "idx_user_game" to table: "user_games"
+DROP INDEX `idx_user_game`;
+-- reverse: create "user_games" table
+DROP TABLE `user_games`;
+-- reverse: create index "idx_games_requested_by" to table: "games"
+DROP INDEX `idx_games_requested_by`;
+-- reverse: add column "visibility" to table: "games"
+ALTER TABLE `games` DROP COLUMN `visibility`;
+-- reverse: add column "requested_by" to table: "games"
+ALTER TABLE `games` DROP COLUMN `requested_by`;
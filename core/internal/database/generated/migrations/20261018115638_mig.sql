@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "role_permissions" table
+CREATE TABLE `role_permissions` (`id` integer NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NULL, `updated_at` datetime NULL, `deleted_at` datetime NULL, `role` text NULL, `permissions` text NULL);
+-- create index "idx_role_permissions_role" to table: "role_permissions"
+CREATE UNIQUE INDEX `idx_role_permissions_role` ON `role_permissions` (`role`);
+-- create index "idx_role_permissions_deleted_at" to table: "role_permissions"
+CREATE INDEX `idx_role_permissions_deleted_at` ON `role_permissions` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_role_permissions_deleted_at" to table: "role_permissions"
+DROP INDEX `idx_role_permissions_deleted_at`;
+-- reverse: create index "idx_role_permissions_role" to table: "role_permissions"
+DROP INDEX `idx_role_permissions_role`;
+-- reverse: create "role_permissions" table
+DROP TABLE `role_permissions`;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- create "game_requests" table
+CREATE TABLE `game_requests` (`id` integer NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NULL, `updated_at` datetime NULL, `deleted_at` datetime NULL, `provider_type` text NULL, `game_db_id` text NULL, `meta` text NULL, `requested_by` integer NULL, `note` text NULL, `status` text NULL, `reviewed_by` integer NULL, `reason` text NULL, `game_id` integer NULL);
+-- create index "idx_game_requests_requested_by" to table: "game_requests"
+CREATE INDEX `idx_game_requests_requested_by` ON `game_requests` (`requested_by`);
+-- create index "idx_request_match" to table: "game_requests"
+CREATE INDEX `idx_request_match` ON `game_requests` (`provider_type`, `game_db_id`);
+-- create index "idx_game_requests_deleted_at" to table: "game_requests"
+CREATE INDEX `idx_game_requests_deleted_at` ON `game_requests` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_game_requests_deleted_at" to table: "game_requests"
+DROP INDEX `idx_game_requests_deleted_at`;
+-- reverse: create index "idx_request_match" to table: "game_requests"
+DROP INDEX `idx_request_match`;
+-- reverse: create index "idx_game_requests_requested_by" to table: "game_requests"
+DROP INDEX `idx_game_requests_requested_by`;
+-- reverse: create "game_requests" table
+DROP TABLE `game_requests`;
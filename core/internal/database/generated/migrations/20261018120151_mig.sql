@@ -0,0 +1,19 @@
+-- +goose Up
+-- create "api_tokens" table
+CREATE TABLE `api_tokens` (`id` integer NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NULL, `updated_at` datetime NULL, `deleted_at` datetime NULL, `user_id` integer NULL, `name` text NULL, `hashed_token` text NULL, `prefix` text NULL, `scopes` text NULL, `expires_at` datetime NULL, `last_used` datetime NULL, CONSTRAINT `fk_api_tokens_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE CASCADE ON DELETE CASCADE);
+-- create index "idx_api_tokens_hashed_token" to table: "api_tokens"
+CREATE UNIQUE INDEX `idx_api_tokens_hashed_token` ON `api_tokens` (`hashed_token`);
+-- create index "idx_api_tokens_user_id" to table: "api_tokens"
+CREATE INDEX `idx_api_tokens_user_id` ON `api_tokens` (`user_id`);
+-- create index "idx_api_tokens_deleted_at" to table: "api_tokens"
+CREATE INDEX `idx_api_tokens_deleted_at` ON `api_tokens` (`deleted_at`);
+
+-- +goose Down
+-- reverse: create index "idx_api_tokens_deleted_at" to table: "api_tokens"
+DROP INDEX `idx_api_tokens_deleted_at`;
+-- reverse: create index "idx_api_tokens_user_id" to table: "api_tokens"
+DROP INDEX `idx_api_tokens_user_id`;
+-- reverse: create index "idx_api_tokens_hashed_token" to table: "api_tokens"
+DROP INDEX `idx_api_tokens_hashed_token`;
+-- reverse: create "api_tokens" table
+DROP TABLE `api_tokens`;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- add column "last_seen" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `last_seen` datetime NULL;
+-- add column "ip" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `ip` text NULL;
+-- add column "user_agent" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `user_agent` text NULL;
+
+-- +goose Down
+-- reverse: add column "user_agent" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `user_agent`;
+-- reverse: add column "ip" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `ip`;
+-- reverse: add column "last_seen" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `last_seen`;
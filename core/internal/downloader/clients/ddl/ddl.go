@@ -0,0 +1,377 @@
+package ddl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ra341/glacier/internal/downloader/types"
+	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/ra341/glacier/pkg/mapsct"
+	"github.com/ra341/glacier/pkg/syncmap"
+
+	"github.com/rs/zerolog/log"
+)
+
+// direct download client, fetches plain http(s) links without an external service
+
+type Config struct {
+	// sent with every request, some file hosts block the default go user agent
+	UserAgent string
+	// number of times a failed transfer is resumed before the download errors out
+	MaxRetries int
+}
+
+const (
+	defaultFilename = "download"
+	retryWait       = 5 * time.Second
+	// headerTimeout limits the wait for the file host to answer a request
+	headerTimeout = 30 * time.Second
+	// stallTimeout fails a transfer that received nothing for this long, it is resumed like any failed attempt
+	stallTimeout = time.Minute
+)
+
+var errStalled = errors.New("download stalled, no data was received")
+
+type Client struct {
+	config Config
+	cli    *http.Client
+	// stallTimeout is a field so tests do not have to wait for the default
+	stallTimeout time.Duration
+
+	jobs syncmap.Map[string, *job]
+}
+
+func New(config map[string]any) (types.Downloader, error) {
+	var conf Config
+
+	err := mapsct.ParseMap(&conf, config)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = headerTimeout
+
+	return &Client{
+		config:       conf,
+		cli:          &http.Client{Transport: transport},
+		stallTimeout: stallTimeout,
+	}, nil
+}
+
+func (c *Client) Type() types.ClientType {
+	return types.ClientHTTP
+}
+
+// job is a single file transfer running in the background
+type job struct {
+	url string
+	// dir that holds the downloaded file, reported as the IncompletePath
+	dir string
+
+	written atomic.Int64
+	total   atomic.Int64
+
+	mu    sync.Mutex
+	state types.DownloadState
+	err   error
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (j *job) setState(state types.DownloadState, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.state = state
+	j.err = err
+}
+
+func (j *job) getState() (types.DownloadState, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.state, j.err
+}
+
+func (c *Client) Download(_ context.Context, url string, downloadPath string) (downloadID string, err error) {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return "", fmt.Errorf("unsupported url, only http(s) links can be downloaded directly: %s", url)
+	}
+
+	downloadID = uuid.NewString()
+	dir := filepath.Join(downloadPath, downloadID)
+	err = os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+
+	c.start(downloadID, url, dir)
+	return downloadID, nil
+}
+
+func (c *Client) Cancel(_ context.Context, download *types.Download, removeDownloaded bool) error {
+	dir := download.IncompletePath
+	j, ok := c.jobs.LoadAndDelete(download.DownloadId)
+	if ok {
+		j.cancel()
+		<-j.done
+		dir = j.dir
+	}
+
+	// finished jobs and jobs from before a restart are not in memory,
+	// their files are still removed from the incomplete path
+	if !removeDownloaded || dir == "" {
+		return nil
+	}
+	return os.RemoveAll(dir)
+}
+
+func (c *Client) Progress(_ context.Context, download *types.Download) (err error) {
+	j, ok := c.jobs.Load(download.DownloadId)
+	if !ok {
+		// glacier was restarted, pick the transfer back up from the partial file
+		if download.IncompletePath == "" {
+			return fmt.Errorf("download not found: %s", download.DownloadId)
+		}
+		j = c.start(download.DownloadId, download.DownloadUrl, download.IncompletePath)
+	}
+
+	state, jobErr := j.getState()
+	written, total := j.written.Load(), j.total.Load()
+
+	download.State = state
+	download.IncompletePath = j.dir
+	download.Complete = uint64(written)
+	download.Left = 0
+	if total > written {
+		download.Left = uint64(total - written)
+	}
+
+	switch {
+	case jobErr != nil:
+		download.Progress = jobErr.Error()
+	case total > 0:
+		download.Progress = fmt.Sprintf("%.2f%%-%s", float64(written)/float64(total)*100, state.String())
+	default:
+		download.Progress = state.String()
+	}
+
+	if state == types.Complete || state == types.Error {
+		// finished jobs are reported once, the tracker stops checking them after this
+		c.jobs.Delete(download.DownloadId)
+	}
+
+	return nil
+}
+
+func (c *Client) start(id, url, dir string) *job {
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &job{
+		url:    url,
+		dir:    dir,
+		state:  types.Downloading,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	c.jobs.Store(id, j)
+
+	go func() {
+		defer close(j.done)
+		defer cancel()
+
+		err := c.run(ctx, j)
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("url", url).Msg("direct download failed")
+			j.setState(types.Error, err)
+			return
+		}
+
+		log.Info().Str("url", url).Msg("direct download complete")
+		j.setState(types.Complete, nil)
+	}()
+
+	return j
+}
+
+// run downloads the file, resuming from where the previous attempt stopped
+func (c *Client) run(ctx context.Context, j *job) error {
+	var err error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			log.Debug().Err(err).Int("attempt", attempt).Str("url", j.url).Msg("retrying direct download")
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryWait):
+			}
+		}
+
+		err = c.transfer(ctx, j)
+		if err == nil || errors.Is(err, context.Canceled) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (c *Client) transfer(ctx context.Context, j *job) error {
+	filename, offset, err := partialFile(j.dir)
+	if err != nil {
+		return err
+	}
+
+	// the request is cancelled once the host stops sending data
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	stall := time.AfterFunc(c.stallTimeout, func() { cancel(errStalled) })
+	defer stall.Stop()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return err
+	}
+	if c.config.UserAgent != "" {
+		req.Header.Set("User-Agent", c.config.UserAgent)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	res, err := c.cli.Do(req)
+	if err != nil {
+		return stallErr(ctx, err)
+	}
+	defer fileutil.Close(res.Body)
+
+	flags := os.O_WRONLY | os.O_CREATE
+	switch res.StatusCode {
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+		// chunked responses do not report a length, the total stays unknown
+		if res.ContentLength >= 0 {
+			j.total.Store(offset + res.ContentLength)
+		}
+	case http.StatusOK:
+		// server ignored the range, start over
+		offset = 0
+		flags |= os.O_TRUNC
+		j.total.Store(res.ContentLength)
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the partial file already holds everything
+		j.written.Store(offset)
+		j.total.Store(offset)
+		return nil
+	default:
+		return fmt.Errorf("unexpected response code:%d %s", res.StatusCode, res.Status)
+	}
+
+	if filename == "" {
+		filename = responseFilename(res)
+	}
+
+	file, err := os.OpenFile(filepath.Join(j.dir, filename), flags, 0644)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(file)
+
+	j.written.Store(offset)
+	_, err = io.Copy(file, &progressReader{
+		r:       res.Body,
+		written: &j.written,
+		stall:   stall,
+		timeout: c.stallTimeout,
+	})
+	if err != nil {
+		return stallErr(ctx, err)
+	}
+
+	if total := j.total.Load(); total > 0 && j.written.Load() != total {
+		return fmt.Errorf("download ended early, got %d of %d bytes", j.written.Load(), total)
+	}
+
+	return nil
+}
+
+// partialFile returns the name and size of the file left by a previous attempt
+func partialFile(dir string) (string, int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", 0, err
+	}
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		info, err := e.Info()
+		if err != nil {
+			return "", 0, err
+		}
+		return e.Name(), info.Size(), nil
+	}
+
+	return "", 0, nil
+}
+
+func responseFilename(res *http.Response) string {
+	_, params, err := mime.ParseMediaType(res.Header.Get("Content-Disposition"))
+	if err == nil {
+		if name := filepath.Base(params["filename"]); name != "." && name != "/" && name != "" {
+			return name
+		}
+	}
+
+	name, err := url.PathUnescape(path.Base(res.Request.URL.Path))
+	if err != nil || name == "." || name == "/" || name == "" {
+		return defaultFilename
+	}
+
+	return filepath.Base(name)
+}
+
+type progressReader struct {
+	r       io.Reader
+	written *atomic.Int64
+
+	// pushed back every time data arrives
+	stall   *time.Timer
+	timeout time.Duration
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.written.Add(int64(n))
+		p.stall.Reset(p.timeout)
+	}
+	return n, err
+}
+
+// stallErr replaces the cancellation of a stalled transfer,
+// run would otherwise treat it as cancelled by the user and stop retrying
+func stallErr(ctx context.Context, err error) error {
+	if errors.Is(context.Cause(ctx), errStalled) {
+		return errStalled
+	}
+	return err
+}
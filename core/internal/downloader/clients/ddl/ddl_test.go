@@ -0,0 +1,202 @@
+package ddl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ra341/glacier/internal/downloader/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testContent = bytes.Repeat([]byte("glacier"), 64*1024)
+
+type fakeHost struct {
+	mu     sync.Mutex
+	ranges []string
+	// blocks the response until closed
+	hold chan struct{}
+}
+
+func newFakeHost(t *testing.T) (*httptest.Server, *fakeHost) {
+	fh := &fakeHost{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fh.mu.Lock()
+		fh.ranges = append(fh.ranges, r.Header.Get("Range"))
+		hold := fh.hold
+		fh.mu.Unlock()
+
+		if hold != nil {
+			<-hold
+		}
+
+		w.Header().Set("Content-Disposition", `attachment; filename="game.zip"`)
+		http.ServeContent(w, r, "game.zip", time.Now(), bytes.NewReader(testContent))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, fh
+}
+
+func newTestClient(t *testing.T) *Client {
+	cli, err := New(map[string]any{
+		"userAgent":  "glacier-test",
+		"maxRetries": 0,
+	})
+	require.NoError(t, err)
+	return cli.(*Client)
+}
+
+func waitDone(t *testing.T, cli *Client, dn *types.Download) {
+	require.Eventually(t, func() bool {
+		require.NoError(t, cli.Progress(context.Background(), dn))
+		return dn.State != types.Downloading
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDownload(t *testing.T) {
+	srv, _ := newFakeHost(t)
+	cli := newTestClient(t)
+	root := t.TempDir()
+
+	id, err := cli.Download(context.Background(), srv.URL+"/files/1", root)
+	require.NoError(t, err)
+
+	dn := &types.Download{DownloadId: id}
+	waitDone(t, cli, dn)
+
+	require.Equal(t, types.Complete, dn.State)
+	require.Equal(t, uint64(len(testContent)), dn.Complete)
+	require.Equal(t, uint64(0), dn.Left)
+	require.Equal(t, filepath.Join(root, id), dn.IncompletePath)
+
+	contents, err := os.ReadFile(filepath.Join(dn.IncompletePath, "game.zip"))
+	require.NoError(t, err)
+	require.Equal(t, testContent, contents)
+}
+
+func TestResumeAfterRestart(t *testing.T) {
+	srv, fh := newFakeHost(t)
+	cli := newTestClient(t)
+
+	// partial file left behind by a previous run
+	dir := filepath.Join(t.TempDir(), "prev")
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	half := len(testContent) / 2
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "game.zip"), testContent[:half], 0644))
+
+	dn := &types.Download{
+		DownloadId:     "prev",
+		DownloadUrl:    srv.URL + "/files/1",
+		IncompletePath: dir,
+	}
+	waitDone(t, cli, dn)
+
+	require.Equal(t, types.Complete, dn.State)
+	require.Equal(t, []string{"bytes=" + strconv.Itoa(half) + "-"}, fh.ranges)
+
+	contents, err := os.ReadFile(filepath.Join(dir, "game.zip"))
+	require.NoError(t, err)
+	require.Equal(t, testContent, contents)
+}
+
+func TestResumeChunked(t *testing.T) {
+	half := len(testContent) / 2
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/*", half, len(testContent)-1))
+		w.WriteHeader(http.StatusPartialContent)
+		// flushing before the body is written sends it chunked without a content length
+		w.(http.Flusher).Flush()
+		_, _ = w.Write(testContent[half:])
+	}))
+	t.Cleanup(srv.Close)
+	cli := newTestClient(t)
+
+	dir := filepath.Join(t.TempDir(), "prev")
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "game.zip"), testContent[:half], 0644))
+
+	dn := &types.Download{
+		DownloadId:     "prev",
+		DownloadUrl:    srv.URL + "/files/1",
+		IncompletePath: dir,
+	}
+	waitDone(t, cli, dn)
+
+	require.Equal(t, types.Complete, dn.State)
+	contents, err := os.ReadFile(filepath.Join(dir, "game.zip"))
+	require.NoError(t, err)
+	require.Equal(t, testContent, contents)
+}
+
+func TestStalled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(testContent)))
+		_, _ = w.Write(testContent[:1024])
+		w.(http.Flusher).Flush()
+		// stop sending without closing the connection
+		<-r.Context().Done()
+	}))
+	t.Cleanup(srv.Close)
+	cli := newTestClient(t)
+	cli.stallTimeout = 100 * time.Millisecond
+
+	id, err := cli.Download(context.Background(), srv.URL+"/files/1", t.TempDir())
+	require.NoError(t, err)
+
+	dn := &types.Download{DownloadId: id}
+	waitDone(t, cli, dn)
+	require.Equal(t, types.Error, dn.State)
+	require.Equal(t, errStalled.Error(), dn.Progress)
+}
+
+func TestCancel(t *testing.T) {
+	srv, fh := newFakeHost(t)
+	fh.hold = make(chan struct{})
+	defer close(fh.hold)
+
+	cli := newTestClient(t)
+	root := t.TempDir()
+
+	id, err := cli.Download(context.Background(), srv.URL+"/files/1", root)
+	require.NoError(t, err)
+	require.DirExists(t, filepath.Join(root, id))
+
+	dn := &types.Download{DownloadId: id}
+	require.NoError(t, cli.Cancel(context.Background(), dn, true))
+	require.NoDirExists(t, filepath.Join(root, id))
+
+	require.Error(t, cli.Progress(context.Background(), dn))
+}
+
+func TestCancelFinished(t *testing.T) {
+	srv, _ := newFakeHost(t)
+	cli := newTestClient(t)
+
+	id, err := cli.Download(context.Background(), srv.URL+"/files/1", t.TempDir())
+	require.NoError(t, err)
+
+	dn := &types.Download{DownloadId: id}
+	waitDone(t, cli, dn)
+	require.DirExists(t, dn.IncompletePath)
+
+	// the job is no longer tracked, its files are still removed
+	require.NoError(t, cli.Cancel(context.Background(), dn, true))
+	require.NoDirExists(t, dn.IncompletePath)
+}
+
+func TestUnsupportedUrl(t *testing.T) {
+	cli := newTestClient(t)
+
+	_, err := cli.Download(context.Background(), "magnet:?xt=urn:btih:abcd", t.TempDir())
+	require.Error(t, err)
+}
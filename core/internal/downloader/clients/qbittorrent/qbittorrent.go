@@ -0,0 +1,250 @@
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ra341/glacier/internal/downloader/types"
+	"github.com/ra341/glacier/pkg/mapsct"
+
+	"github.com/rs/zerolog/log"
+	"resty.dev/v3"
+)
+
+// talks to the qBittorrent WebUI API v2
+// https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)
+
+type Config struct {
+	Protocol string
+	Host     string
+	User     string
+	Password string
+}
+
+const (
+	// tagPrefix marks torrents added by glacier,
+	// qbittorrent does not return the hash on add so the tag is used to find it
+	tagPrefix = "glacier-"
+
+	// how long to wait for qbittorrent to register a newly added torrent
+	addLookupRetries = 10
+	addLookupWait    = 500 * time.Millisecond
+)
+
+type Client struct {
+	config Config
+	cli    *resty.Client
+}
+
+func New(config map[string]any) (types.Downloader, error) {
+	var conf Config
+
+	err := mapsct.ParseMap(&conf, config)
+	if err != nil {
+		return nil, err
+	}
+
+	qbit := &Client{
+		config: conf,
+		cli: resty.New().
+			SetBaseURL(fmt.Sprintf("%s://%s/api/v2", conf.Protocol, conf.Host)).
+			// qbittorrent rejects requests without a matching referer when CSRF protection is on
+			SetHeader("Referer", fmt.Sprintf("%s://%s", conf.Protocol, conf.Host)),
+	}
+
+	version, err := qbit.test()
+	if err != nil {
+		return nil, fmt.Errorf("qbittorrent client health check failed: %w", err)
+	}
+	log.Debug().Str("version", version).Msg("connected to qbittorrent")
+
+	return qbit, nil
+}
+
+func (qb *Client) Type() types.ClientType {
+	return types.ClientQBittorrent
+}
+
+func (qb *Client) test() (string, error) {
+	err := qb.login(context.Background())
+	if err != nil {
+		return "", err
+	}
+
+	res, err := qb.request(context.Background(), func(r *resty.Request) (*resty.Response, error) {
+		return r.Get("/app/webapiVersion")
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res.String(), nil
+}
+
+func (qb *Client) login(ctx context.Context) error {
+	res, err := qb.cli.R().
+		SetContext(ctx).
+		SetFormData(map[string]string{
+			"username": qb.config.User,
+			"password": qb.config.Password,
+		}).
+		Post("/auth/login")
+	if err != nil {
+		return err
+	}
+
+	if res.IsError() {
+		return fmt.Errorf("login failed code:%d %s", res.StatusCode(), res.String())
+	}
+
+	// qbittorrent returns 200 with "Fails." on bad credentials
+	if strings.TrimSpace(res.String()) != "Ok." {
+		return fmt.Errorf("login failed: invalid username or password")
+	}
+
+	return nil
+}
+
+// request runs the request and logs in again if the session cookie has expired
+func (qb *Client) request(ctx context.Context, do func(r *resty.Request) (*resty.Response, error)) (*resty.Response, error) {
+	res, err := do(qb.cli.R().SetContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() == http.StatusForbidden {
+		log.Debug().Msg("qbittorrent session expired, logging in again")
+		if err = qb.login(ctx); err != nil {
+			return nil, err
+		}
+
+		res, err = do(qb.cli.R().SetContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if res.IsError() {
+		return nil, fmt.Errorf("qbittorrent request failed code:%d %s", res.StatusCode(), res.String())
+	}
+
+	return res, nil
+}
+
+func (qb *Client) Download(ctx context.Context, url string, downloadPath string) (downloadID string, err error) {
+	tag := tagPrefix + uuid.NewString()
+
+	_, err = qb.request(ctx, func(r *resty.Request) (*resty.Response, error) {
+		return r.SetMultipartFormData(map[string]string{
+			"urls":     url,
+			"savepath": downloadPath,
+			"tags":     tag,
+		}).Post("/torrents/add")
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// the torrent is added asynchronously, poll until it shows up
+	for range addLookupRetries {
+		torrents, err := qb.listTorrents(ctx, map[string]string{"tag": tag})
+		if err != nil {
+			return "", err
+		}
+
+		if len(torrents) > 0 {
+			return torrents[0].Hash, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(addLookupWait):
+		}
+	}
+
+	return "", fmt.Errorf("torrent was added but qbittorrent did not report it, tag: %s", tag)
+}
+
+func (qb *Client) Cancel(ctx context.Context, download *types.Download, removeDownloaded bool) error {
+	_, err := qb.request(ctx, func(r *resty.Request) (*resty.Response, error) {
+		return r.SetFormData(map[string]string{
+			"hashes":      download.DownloadId,
+			"deleteFiles": strconv.FormatBool(removeDownloaded),
+		}).Post("/torrents/delete")
+	})
+	return err
+}
+
+func (qb *Client) Progress(ctx context.Context, download *types.Download) (err error) {
+	torrents, err := qb.listTorrents(ctx, map[string]string{"hashes": download.DownloadId})
+	if err != nil {
+		return err
+	}
+	if len(torrents) == 0 {
+		return fmt.Errorf("torrent not found")
+	}
+
+	info := torrents[0]
+
+	download.State = info.downloadState()
+	download.Complete = uint64(info.Completed)
+	download.Left = uint64(info.AmountLeft)
+	download.Progress = fmt.Sprintf(
+		"%.2f%%-%s",
+		info.Progress*100,
+		info.State,
+	)
+
+	download.IncompletePath = info.ContentPath
+	if download.IncompletePath == "" {
+		// content_path is only reported by qbittorrent >= 4.3.2
+		download.IncompletePath = filepath.Join(info.SavePath, info.Name)
+	}
+
+	return nil
+}
+
+type TorrentInfo struct {
+	Hash        string  `json:"hash"`
+	Name        string  `json:"name"`
+	State       string  `json:"state"`
+	Progress    float64 `json:"progress"`
+	Size        int64   `json:"size"`
+	Completed   int64   `json:"completed"`
+	AmountLeft  int64   `json:"amount_left"`
+	SavePath    string  `json:"save_path"`
+	ContentPath string  `json:"content_path"`
+}
+
+func (t *TorrentInfo) downloadState() types.DownloadState {
+	switch t.State {
+	case "error", "missingFiles":
+		return types.Error
+	case "uploading", "stalledUP", "pausedUP", "stoppedUP", "queuedUP", "forcedUP":
+		return types.Complete
+	default:
+		return types.Downloading
+	}
+}
+
+func (qb *Client) listTorrents(ctx context.Context, filter map[string]string) ([]TorrentInfo, error) {
+	var torrents []TorrentInfo
+
+	_, err := qb.request(ctx, func(r *resty.Request) (*resty.Response, error) {
+		return r.SetQueryParams(filter).
+			SetResult(&torrents).
+			Get("/torrents/info")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return torrents, nil
+}
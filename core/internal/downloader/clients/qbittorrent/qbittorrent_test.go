@@ -0,0 +1,186 @@
+package qbittorrent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ra341/glacier/internal/downloader/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUser = "admin"
+	testPass = "adminadmin"
+	testSid  = "test-session"
+)
+
+// fakeQbit is a minimal stand-in for the qbittorrent WebUI API
+type fakeQbit struct {
+	mu       sync.Mutex
+	torrents map[string]*TorrentInfo
+	// tag -> hash
+	tags    map[string]string
+	deleted map[string]bool
+}
+
+func newFakeQbit(t *testing.T) (*httptest.Server, *fakeQbit) {
+	fq := &fakeQbit{
+		torrents: map[string]*TorrentInfo{},
+		tags:     map[string]string{},
+		deleted:  map[string]bool{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v2/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("username") != testUser || r.FormValue("password") != testPass {
+			_, _ = w.Write([]byte("Fails."))
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "SID", Value: testSid, Path: "/"})
+		_, _ = w.Write([]byte("Ok."))
+	})
+	mux.HandleFunc("GET /api/v2/app/webapiVersion", fq.authed(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("2.11.2"))
+	}))
+	mux.HandleFunc("POST /api/v2/torrents/add", fq.authed(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+
+		fq.mu.Lock()
+		defer fq.mu.Unlock()
+
+		hash := "hash" + r.FormValue("urls")[len(r.FormValue("urls"))-4:]
+		fq.torrents[hash] = &TorrentInfo{
+			Hash:        hash,
+			Name:        "game",
+			State:       "downloading",
+			Progress:    0.5,
+			Size:        100,
+			Completed:   50,
+			AmountLeft:  50,
+			SavePath:    r.FormValue("savepath"),
+			ContentPath: r.FormValue("savepath") + "/game",
+		}
+		fq.tags[r.FormValue("tags")] = hash
+		_, _ = w.Write([]byte("Ok."))
+	}))
+	mux.HandleFunc("GET /api/v2/torrents/info", fq.authed(func(w http.ResponseWriter, r *http.Request) {
+		fq.mu.Lock()
+		defer fq.mu.Unlock()
+
+		var res []TorrentInfo
+		if tag := r.URL.Query().Get("tag"); tag != "" {
+			if hash, ok := fq.tags[tag]; ok {
+				res = append(res, *fq.torrents[hash])
+			}
+		}
+		for _, hash := range strings.Split(r.URL.Query().Get("hashes"), "|") {
+			if info, ok := fq.torrents[hash]; ok {
+				res = append(res, *info)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	mux.HandleFunc("POST /api/v2/torrents/delete", fq.authed(func(w http.ResponseWriter, r *http.Request) {
+		fq.mu.Lock()
+		defer fq.mu.Unlock()
+
+		hash := r.FormValue("hashes")
+		delete(fq.torrents, hash)
+		fq.deleted[hash] = r.FormValue("deleteFiles") == "true"
+	}))
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv, fq
+}
+
+func (fq *fakeQbit) authed(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("SID")
+		if err != nil || cookie.Value != testSid {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func newTestClient(srv *httptest.Server, password string) (types.Downloader, error) {
+	return New(map[string]any{
+		"protocol": "http",
+		"host":     strings.TrimPrefix(srv.URL, "http://"),
+		"user":     testUser,
+		"password": password,
+	})
+}
+
+func TestLoginFailure(t *testing.T) {
+	srv, _ := newFakeQbit(t)
+
+	_, err := newTestClient(srv, "wrong")
+	require.Error(t, err)
+}
+
+func TestDownloadProgressCancel(t *testing.T) {
+	srv, fq := newFakeQbit(t)
+	ctx := context.Background()
+
+	cli, err := newTestClient(srv, testPass)
+	require.NoError(t, err)
+
+	id, err := cli.Download(ctx, "magnet:?xt=urn:btih:abcd", "/downloads")
+	require.NoError(t, err)
+	require.Equal(t, "hashabcd", id)
+
+	dn := &types.Download{DownloadId: id}
+	require.NoError(t, cli.Progress(ctx, dn))
+	require.Equal(t, types.Downloading, dn.State)
+	require.Equal(t, uint64(50), dn.Complete)
+	require.Equal(t, uint64(50), dn.Left)
+	require.Equal(t, "/downloads/game", dn.IncompletePath)
+
+	fq.mu.Lock()
+	fq.torrents[id].State = "stalledUP"
+	fq.torrents[id].Completed = 100
+	fq.torrents[id].AmountLeft = 0
+	fq.mu.Unlock()
+
+	require.NoError(t, cli.Progress(ctx, dn))
+	require.Equal(t, types.Complete, dn.State)
+	require.Equal(t, uint64(0), dn.Left)
+
+	require.NoError(t, cli.Cancel(ctx, dn, true))
+	require.True(t, fq.deleted[id])
+
+	require.Error(t, cli.Progress(ctx, dn))
+}
+
+func TestSessionExpiry(t *testing.T) {
+	srv, fq := newFakeQbit(t)
+	ctx := context.Background()
+
+	cli, err := newTestClient(srv, testPass)
+	require.NoError(t, err)
+
+	// drop the session cookie, the client should log in again
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	cli.(*Client).cli.SetCookieJar(jar)
+
+	fq.mu.Lock()
+	fq.torrents["hash1234"] = &TorrentInfo{Hash: "hash1234", State: "error"}
+	fq.mu.Unlock()
+
+	dn := &types.Download{DownloadId: "hash1234"}
+	require.NoError(t, cli.Progress(ctx, dn))
+	require.Equal(t, types.Error, dn.State)
+}
@@ -111,8 +111,29 @@ func (tm *Client) downloadMagnet(ctx context.Context, magnet, downloadPath, cate
 	return strconv.FormatInt(*torrentResult.ID, 10), nil
 }
 
-func (tm *Client) Cancel(ctx context.Context, downloadId string, removeDownloaded bool) error {
-	return fmt.Errorf(" this function is not implemented you idiot")
+func (tm *Client) Cancel(ctx context.Context, download *types.Download, removeDownloaded bool) error {
+	downloadId := download.DownloadId
+	torrentId, err := strconv.ParseInt(downloadId, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid torrent id %s: %w", downloadId, err)
+	}
+	ids := []int64{torrentId}
+
+	// stop first so transmission releases the files before removal
+	err = tm.cli.TorrentStopIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("could not stop torrent %s: %w", downloadId, err)
+	}
+
+	err = tm.cli.TorrentRemove(ctx, transmissionrpc.TorrentRemovePayload{
+		IDs:             ids,
+		DeleteLocalData: removeDownloaded,
+	})
+	if err != nil {
+		return fmt.Errorf("could not remove torrent %s: %w", downloadId, err)
+	}
+
+	return nil
 }
 
 func (tm *Client) Progress(ctx context.Context, download *types.Download) (err error) {
@@ -10,7 +10,9 @@ type ConfigLoader func() *Config
 
 type Config struct {
 	CheckInterval  string `yaml:"checkInterval" default:"30m" env:"DOWNLOAD_CHECK_TIME" help:"time between checking games status"`
+	WatchInterval  string `yaml:"watchInterval" default:"5s" env:"DOWNLOAD_WATCH_TIME" help:"time between checking games status while a client is watching downloads"`
 	IncompletePath string `yaml:"incompletePath" default:"./incomplete" env:"INCOMPLETE_DIR" help:"places downloading games here"`
+	Extract        bool   `yaml:"extract" default:"false" env:"DOWNLOAD_EXTRACT" help:"extract zip, rar and 7z archives into the game dir after a download completes"`
 }
 
 func (c *Config) Interval() time.Duration {
@@ -23,3 +25,14 @@ func (c *Config) Interval() time.Duration {
 
 	return duration
 }
+
+func (c *Config) WatchDuration() time.Duration {
+	duration, err := time.ParseDuration(c.WatchInterval)
+	if err != nil {
+		const defaultWatchInterval = 5 * time.Second
+		log.Warn().Err(err).Str("interval", c.WatchInterval).Msg("can't parse watch interval")
+		duration = defaultWatchInterval
+	}
+
+	return duration
+}
@@ -0,0 +1,286 @@
+package extract
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bodgit/sevenzip"
+	"github.com/nwaples/rardecode/v2"
+	"github.com/ra341/glacier/pkg/fileutil"
+)
+
+type Kind int
+
+const (
+	// NotArchive is a regular file
+	NotArchive Kind = iota
+	// First is a single archive or the first volume of a multipart archive,
+	// extracting it reads every following volume
+	First
+	// Volume is a following volume of a multipart archive
+	Volume
+)
+
+var (
+	rarPartRegex  = regexp.MustCompile(`(?i)\.part(\d+)\.rar$`)
+	rarOldVolume  = regexp.MustCompile(`(?i)\.r\d{2,3}$`)
+	sevenZipSplit = regexp.MustCompile(`(?i)\.7z\.(\d{3})$`)
+)
+
+// Detect reports if the file is an archive that can be extracted from its name
+func Detect(path string) Kind {
+	name := strings.ToLower(filepath.Base(path))
+
+	if match := rarPartRegex.FindStringSubmatch(name); match != nil {
+		if num, _ := strconv.Atoi(match[1]); num == 1 {
+			return First
+		}
+		return Volume
+	}
+
+	if match := sevenZipSplit.FindStringSubmatch(name); match != nil {
+		if match[1] == "001" {
+			return First
+		}
+		return Volume
+	}
+
+	switch {
+	case strings.HasSuffix(name, ".zip"),
+		strings.HasSuffix(name, ".7z"),
+		strings.HasSuffix(name, ".rar"):
+		return First
+	case rarOldVolume.MatchString(name):
+		return Volume
+	}
+
+	return NotArchive
+}
+
+// Find lists the archives in dir that need to be extracted,
+// following volumes are left out since they are read through the first one
+func Find(dir string) ([]string, error) {
+	var archives []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() && Detect(path) == First {
+			archives = append(archives, path)
+		}
+		return nil
+	})
+
+	return archives, err
+}
+
+// Progress is called with the uncompressed bytes written so far
+type Progress func(done, total uint64)
+
+// Extract unpacks the archive into dest, overwriting existing files
+func Extract(ctx context.Context, archive string, dest string, progress Progress) error {
+	name := strings.ToLower(archive)
+
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return extractZip(ctx, archive, dest, progress)
+	case strings.HasSuffix(name, ".7z"), sevenZipSplit.MatchString(name):
+		return extract7z(ctx, archive, dest, progress)
+	case strings.HasSuffix(name, ".rar"):
+		return extractRar(ctx, archive, dest, progress)
+	}
+
+	return fmt.Errorf("unsupported archive: %s", archive)
+}
+
+func extractZip(ctx context.Context, archive, dest string, progress Progress) error {
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(reader)
+
+	var total uint64
+	for _, f := range reader.File {
+		total += f.UncompressedSize64
+	}
+
+	w := newWriter(ctx, dest, total, progress)
+	for _, f := range reader.File {
+		err = w.write(f.Name, f.Mode(), f.FileInfo().IsDir(), f.Open)
+		if err != nil {
+			return fmt.Errorf("could not extract %s: %w", f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func extract7z(ctx context.Context, archive, dest string, progress Progress) error {
+	// handles .7z.001 volumes by itself
+	reader, err := sevenzip.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(reader)
+
+	var total uint64
+	for _, f := range reader.File {
+		total += f.UncompressedSize
+	}
+
+	w := newWriter(ctx, dest, total, progress)
+	for _, f := range reader.File {
+		err = w.write(f.Name, f.Mode(), f.FileInfo().IsDir(), f.Open)
+		if err != nil {
+			return fmt.Errorf("could not extract %s: %w", f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func extractRar(ctx context.Context, archive, dest string, progress Progress) error {
+	files, err := rardecode.List(archive)
+	if err != nil {
+		return err
+	}
+
+	var total uint64
+	for _, f := range files {
+		total += uint64(f.UnPackedSize)
+	}
+
+	// read sequentially so solid archives work, the reader moves across volumes
+	reader, err := rardecode.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(reader)
+
+	w := newWriter(ctx, dest, total, progress)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = w.write(header.Name, header.Mode(), header.IsDir, func() (io.ReadCloser, error) {
+			return io.NopCloser(reader), nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not extract %s: %w", header.Name, err)
+		}
+	}
+}
+
+type writer struct {
+	ctx      context.Context
+	dest     string
+	done     uint64
+	total    uint64
+	progress Progress
+}
+
+func newWriter(ctx context.Context, dest string, total uint64, progress Progress) *writer {
+	if progress == nil {
+		progress = func(done, total uint64) {}
+	}
+	progress(0, total)
+
+	return &writer{
+		ctx:      ctx,
+		dest:     dest,
+		total:    total,
+		progress: progress,
+	}
+}
+
+func (w *writer) write(name string, mode fs.FileMode, isDir bool, open func() (io.ReadCloser, error)) error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+
+	target, err := w.target(name)
+	if err != nil {
+		return err
+	}
+
+	if isDir {
+		return os.MkdirAll(target, os.ModePerm)
+	}
+
+	err = os.MkdirAll(filepath.Dir(target), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	src, err := open()
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(src)
+
+	perm := mode.Perm()
+	if perm == 0 {
+		perm = 0644
+	}
+	dst, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(dst)
+
+	// 1MB buffer to keep the pipeline full
+	buf := make([]byte, 1024*1024)
+	for {
+		if err = w.ctx.Err(); err != nil {
+			return err
+		}
+
+		n, readErr := src.Read(buf)
+		if n > 0 {
+			if _, err = dst.Write(buf[:n]); err != nil {
+				return err
+			}
+			w.done += uint64(n)
+			w.progress(w.done, w.total)
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
+
+// target resolves the entry path inside dest, rejecting entries that escape it
+func (w *writer) target(name string) (string, error) {
+	target := filepath.Join(w.dest, filepath.FromSlash(name))
+
+	rel, err := filepath.Rel(w.dest, target)
+	if err != nil {
+		return "", err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(rel) {
+		return "", fmt.Errorf("archive entry %s is outside the destination", name)
+	}
+
+	return target, nil
+}
@@ -0,0 +1,93 @@
+package extract
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	cases := map[string]Kind{
+		"setup.exe":              NotArchive,
+		"data.bin":               NotArchive,
+		"game.zip":               First,
+		"game.7z":                First,
+		"game.7z.001":            First,
+		"game.7z.002":            Volume,
+		"game.rar":               First,
+		"game.r00":               Volume,
+		"game.r01":               Volume,
+		"Game.Part1.rar":         First,
+		"game.part01.rar":        First,
+		"game.part02.rar":        Volume,
+		"game.part10.rar":        Volume,
+		"dir/fitgirl-repack.zip": First,
+	}
+
+	for name, want := range cases {
+		require.Equal(t, want, Detect(name), name)
+	}
+}
+
+func writeZip(t *testing.T, path string, files map[string]string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, contents := range files {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+}
+
+func TestExtractZip(t *testing.T) {
+	src := t.TempDir()
+	dest := t.TempDir()
+
+	archive := filepath.Join(src, "game.zip")
+	writeZip(t, archive, map[string]string{
+		"game.exe":         "exe",
+		"data/level1.pak":  "level one",
+		"data/sub/cfg.ini": "cfg",
+	})
+	require.NoError(t, os.WriteFile(filepath.Join(src, "readme.txt"), []byte("hi"), 0644))
+
+	archives, err := Find(src)
+	require.NoError(t, err)
+	require.Equal(t, []string{archive}, archives)
+
+	var lastDone, lastTotal uint64
+	err = Extract(context.Background(), archive, dest, func(done, total uint64) {
+		lastDone, lastTotal = done, total
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len("exe")+len("level one")+len("cfg")), lastTotal)
+	require.Equal(t, lastTotal, lastDone)
+
+	contents, err := os.ReadFile(filepath.Join(dest, "data", "level1.pak"))
+	require.NoError(t, err)
+	require.Equal(t, "level one", string(contents))
+	require.FileExists(t, filepath.Join(dest, "data", "sub", "cfg.ini"))
+}
+
+func TestExtractRejectsEscapingEntries(t *testing.T) {
+	src := t.TempDir()
+	dest := filepath.Join(t.TempDir(), "game")
+
+	archive := filepath.Join(src, "evil.zip")
+	writeZip(t, archive, map[string]string{
+		"../outside.txt": "nope",
+	})
+
+	err := Extract(context.Background(), archive, dest, nil)
+	require.Error(t, err)
+	require.NoFileExists(t, filepath.Join(filepath.Dir(dest), "outside.txt"))
+}
@@ -9,8 +9,12 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ra341/glacier/internal/downloader/extract"
 	"github.com/ra341/glacier/internal/downloader/types"
 	"github.com/ra341/glacier/internal/library"
+	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/ra341/glacier/pkg/pubsub"
+	"github.com/ra341/glacier/pkg/syncmap"
 
 	"github.com/rs/zerolog/log"
 )
@@ -26,6 +30,13 @@ type Service struct {
 	isDownloadTrackerRunning atomic.Bool
 	trackerCtxCancelFn       context.CancelFunc
 	triggerChan              chan struct{}
+
+	// game id -> running archive extraction
+	extractions syncmap.Map[uint, *extraction]
+
+	events pubsub.Broker[library.DownloadEvent]
+	// space of running downloads and extractions that is not written yet
+	space *fileutil.SpaceReserver
 }
 
 func New(cli GetCli, cmf CheckMetaFn, store library.Store, conf ConfigLoader) *Service {
@@ -34,6 +45,7 @@ func New(cli GetCli, cmf CheckMetaFn, store library.Store, conf ConfigLoader) *S
 		store: store,
 		conf:  conf,
 		cmf:   cmf,
+		space: fileutil.NewSpaceReserver(nil),
 	}
 }
 
@@ -43,6 +55,16 @@ func (s *Service) Add(ctx context.Context, game *library.Game) (err error) {
 		return err
 	}
 
+	err = s.reserveSpace(game)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			s.releaseSpace(game.ID)
+		}
+	}()
+
 	downloadId, err := downloader.Download(
 		ctx,
 		game.Download.DownloadUrl,
@@ -54,7 +76,7 @@ func (s *Service) Add(ctx context.Context, game *library.Game) (err error) {
 	game.Download.State = types.Downloading
 	game.Download.DownloadId = downloadId
 
-	err = s.store.UpdateDownloadProgress(ctx, game.ID, game.Download)
+	err = s.saveProgress(ctx, game)
 	if err != nil {
 		return err
 	}
@@ -65,12 +87,76 @@ func (s *Service) Add(ctx context.Context, game *library.Game) (err error) {
 	return err
 }
 
-func (s *Service) Cancel(ctx context.Context, name string, downloadID string, removeFiles bool) error {
-	downloader, err := s.cli(name)
+func (s *Service) Cancel(ctx context.Context, download *types.Download, removeFiles bool) error {
+	downloader, err := s.cli(download.Client)
+	if err != nil {
+		return err
+	}
+	return downloader.Cancel(ctx, download, removeFiles)
+}
+
+// Remove stops the download of the game in its client and cleans up any incomplete files
+func (s *Service) Remove(ctx context.Context, game *library.Game) error {
+	if game.Download.DownloadId != "" {
+		err := s.Cancel(ctx, &game.Download, true)
+		if err != nil {
+			// the client may have already removed it, do not block cleanup
+			log.Warn().Err(err).
+				Str("client", game.Download.Client).
+				Str("download", game.Download.DownloadId).
+				Msg("could not cancel download")
+		}
+	}
+
+	if job, ok := s.extractions.LoadAndDelete(game.ID); ok {
+		job.cancel()
+		<-job.finished
+	}
+	s.releaseSpace(game.ID)
+
+	err := fileutil.RemoveAllWithin(s.conf().IncompletePath, game.Download.IncompletePath)
+	if err != nil {
+		return fmt.Errorf("could not remove incomplete files: %w", err)
+	}
+
+	return nil
+}
+
+// Watch streams the download state of games as the tracker updates them,
+// close the subscription to stop watching.
+// download clients are not pushing changes, the tracker polls them every WatchDuration while anyone is watching
+func (s *Service) Watch() *pubsub.Subscription[library.DownloadEvent] {
+	sub := s.events.Subscribe(64)
+	// watchers shorten the tracker interval, the next check must use it
+	s.TriggerTracker()
+	return sub
+}
+
+// saveProgress stores the download state and sends it to watchers
+func (s *Service) saveProgress(ctx context.Context, game *library.Game) error {
+	if game.Download.State == types.Complete || game.Download.State == types.Error {
+		s.releaseSpace(game.ID)
+	}
+
+	err := s.store.UpdateDownloadProgress(ctx, game.ID, game.Download)
 	if err != nil {
 		return err
 	}
-	return downloader.Cancel(ctx, downloadID, removeFiles)
+
+	s.events.Publish(library.DownloadEvent{
+		GameId:   game.ID,
+		Download: game.Download,
+	})
+	return nil
+}
+
+// interval is the time between checks, watchers switch it to polling every WatchDuration
+func (s *Service) interval() time.Duration {
+	interval := s.conf().Interval()
+	if s.events.Len() > 0 {
+		interval = min(interval, s.conf().WatchDuration())
+	}
+	return interval
 }
 
 func (s *Service) StartTracker() {
@@ -112,7 +198,7 @@ func (s *Service) downloadTracker(ctx context.Context) {
 	s.isDownloadTrackerRunning.Store(true)
 	defer s.isDownloadTrackerRunning.Store(false)
 
-	timer := time.NewTimer(s.conf().Interval())
+	timer := time.NewTimer(s.interval())
 	defer timer.Stop()
 
 	errTries := 0
@@ -134,11 +220,18 @@ func (s *Service) downloadTracker(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		}
+
+		timer.Reset(s.interval())
 	}
 }
 
 func (s *Service) trackDownloader(ctx context.Context, errTries int) (int, bool) {
 	downloading, err := s.store.ListDownloadState(ctx, types.Downloading)
+	if err == nil {
+		var extracting []library.Game
+		extracting, err = s.store.ListDownloadState(ctx, types.Extracting)
+		downloading = append(downloading, extracting...)
+	}
 	if err != nil {
 		log.Warn().Err(err).Msg("could not check downloads")
 		errTries++
@@ -164,13 +257,18 @@ func (s *Service) trackDownloader(ctx context.Context, errTries int) (int, bool)
 // checks a single download
 func (s *Service) checkDownload(ctx context.Context, dn *library.Game) {
 	defer func() {
-		err := s.store.UpdateDownloadProgress(ctx, dn.ID, dn.Download)
+		err := s.saveProgress(ctx, dn)
 		if err != nil {
 			log.Warn().Err(err).Str("download", dn.Download.DownloadId).Msg("failed to update download state")
 		}
 	}()
 	download := dn.Download
 
+	if download.State == types.Extracting {
+		s.checkExtraction(dn)
+		return
+	}
+
 	downloader, err := s.cli(download.Client)
 	if err != nil {
 		dn.Download.State = types.Error
@@ -199,6 +297,8 @@ func (s *Service) completeGameDownload(game *library.Game) {
 		return
 	}
 
+	extractArchives := s.conf().Extract
+
 	err = filepath.WalkDir(game.Download.IncompletePath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -224,6 +324,11 @@ func (s *Service) completeGameDownload(game *library.Game) {
 			return nil
 		}
 
+		if extractArchives && extract.Detect(path) != extract.NotArchive {
+			// unpacked into the game dir by the extraction stage instead
+			return nil
+		}
+
 		parentDir := filepath.Dir(targetPath)
 		err = os.MkdirAll(parentDir, os.ModePerm)
 		if err != nil {
@@ -243,13 +348,38 @@ func (s *Service) completeGameDownload(game *library.Game) {
 		return
 	}
 
+	if extractArchives {
+		archives, err := extract.Find(game.Download.IncompletePath)
+		if err != nil {
+			game.Download.State = types.Error
+			game.Download.Progress = fmt.Sprintf("could not look for archives: %v", err)
+			return
+		}
+
+		if len(archives) > 0 {
+			// manifest is generated once extraction finishes
+			s.startExtraction(game, archives)
+			return
+		}
+	}
+
+	s.generateManifest(game)
+}
+
+func (s *Service) generateManifest(game *library.Game) {
+	// the manifest is generated from the stored game, it must be marked complete first
+	err := s.saveProgress(context.Background(), game)
+	if err != nil {
+		log.Warn().Err(err).Str("name", game.Meta.Name).Msg("failed to update download state")
+	}
+
 	now := time.Now()
 	log.Info().Str("name", game.Meta.Name).Msg("generating manifest")
 
 	s.cmf(int(game.ID))
 
 	log.Debug().
-		Str("title", game.Meta.Name[:24]+"...").
+		Str("title", game.Meta.Name).
 		Str("meta-took", time.Since(now).String()).
 		Msg("download complete with no errors")
 }
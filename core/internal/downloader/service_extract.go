@@ -0,0 +1,134 @@
+package downloader
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/ra341/glacier/internal/downloader/extract"
+	"github.com/ra341/glacier/internal/downloader/types"
+	"github.com/ra341/glacier/internal/library"
+
+	"github.com/rs/zerolog/log"
+)
+
+// how often a running extraction wakes the tracker to save its progress
+const extractTriggerInterval = 10 * time.Second
+
+// extraction unpacks the archives of a completed download in the background,
+// its progress is picked up by the download tracker like a download client
+type extraction struct {
+	archives int
+	current  atomic.Int64
+	done     atomic.Uint64
+	total    atomic.Uint64
+
+	cancel   context.CancelFunc
+	finished chan struct{}
+	// only read after finished is closed
+	err error
+}
+
+func (s *Service) startExtraction(game *library.Game, archives []string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &extraction{
+		archives: len(archives),
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	if _, running := s.extractions.LoadOrStore(game.ID, job); running {
+		cancel()
+		return
+	}
+
+	game.Download.State = types.Extracting
+	game.Download.Extracted = 0
+	game.Download.ExtractTotal = 0
+	game.Download.Progress = fmt.Sprintf("extracting %d archives", len(archives))
+
+	dest := game.Download.DownloadPath
+	name := game.Meta.Name
+
+	go func() {
+		defer close(job.finished)
+		defer cancel()
+		defer s.TriggerTracker()
+
+		lastTrigger := time.Now()
+		// totals are only known once an archive is opened, so offset by the finished ones
+		var prevDone, prevTotal uint64
+
+		for i, archive := range archives {
+			job.current.Store(int64(i + 1))
+			log.Info().Str("name", name).Str("archive", filepath.Base(archive)).Msg("extracting archive")
+
+			var archiveDone, archiveTotal uint64
+			err := extract.Extract(ctx, archive, dest, func(done, total uint64) {
+				archiveDone, archiveTotal = done, total
+				job.done.Store(prevDone + done)
+				job.total.Store(prevTotal + total)
+
+				if time.Since(lastTrigger) > extractTriggerInterval {
+					lastTrigger = time.Now()
+					s.TriggerTracker()
+				}
+			})
+			if err != nil {
+				job.err = fmt.Errorf("could not extract %s: %w", filepath.Base(archive), err)
+				return
+			}
+
+			prevDone += archiveDone
+			prevTotal += archiveTotal
+		}
+
+		log.Info().Str("name", name).Int("archives", len(archives)).Msg("extraction complete")
+	}()
+}
+
+// checkExtraction updates the game with the extraction progress
+// and generates the manifest once it is done
+func (s *Service) checkExtraction(game *library.Game) {
+	job, ok := s.extractions.Load(game.ID)
+	if !ok {
+		// glacier was restarted mid extraction, files are overwritten so start over
+		archives, err := extract.Find(game.Download.IncompletePath)
+		if err != nil {
+			game.SetErr(fmt.Errorf("could not look for archives: %w", err))
+			return
+		}
+
+		s.startExtraction(game, archives)
+		return
+	}
+
+	game.Download.Extracted = job.done.Load()
+	game.Download.ExtractTotal = job.total.Load()
+
+	select {
+	case <-job.finished:
+	default:
+		var percent float64
+		if game.Download.ExtractTotal > 0 {
+			percent = float64(game.Download.Extracted) / float64(game.Download.ExtractTotal) * 100
+		}
+		game.Download.Progress = fmt.Sprintf(
+			"extracting archive %d/%d %.2f%%",
+			job.current.Load(), job.archives, percent,
+		)
+		return
+	}
+
+	s.extractions.Delete(game.ID)
+
+	if job.err != nil {
+		game.SetErr(job.err)
+		return
+	}
+
+	game.Download.State = types.Complete
+	game.Download.Progress = "extraction complete"
+	s.generateManifest(game)
+}
@@ -0,0 +1,71 @@
+package downloader
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ra341/glacier/internal/library"
+
+	"github.com/dustin/go-humanize"
+	"github.com/rs/zerolog/log"
+)
+
+// reserveSpace fails early when the incomplete dir cannot hold the download,
+// with extraction enabled the game dir must also hold the extracted files
+func (s *Service) reserveSpace(game *library.Game) error {
+	size, err := parseSize(game.Source.FileSize)
+	if err != nil || size == 0 {
+		log.Debug().Err(err).
+			Str("size", game.Source.FileSize).
+			Str("name", game.Meta.Name).
+			Msg("unknown download size, skipping free space check")
+		return nil
+	}
+
+	err = s.space.Reserve(incompleteSpaceKey(game.ID), s.conf().IncompletePath, size)
+	if err != nil {
+		return err
+	}
+
+	if s.conf().Extract {
+		// archives usually unpack to at least their own size
+		err = s.space.Reserve(extractSpaceKey(game.ID), game.Download.DownloadPath, size)
+		if err != nil {
+			s.space.Release(incompleteSpaceKey(game.ID))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) releaseSpace(gameId uint) {
+	s.space.Release(incompleteSpaceKey(gameId))
+	s.space.Release(extractSpaceKey(gameId))
+}
+
+func incompleteSpaceKey(gameId uint) string {
+	return fmt.Sprintf("incomplete/%d", gameId)
+}
+
+func extractSpaceKey(gameId uint) string {
+	return fmt.Sprintf("extract/%d", gameId)
+}
+
+// parseSize reads sizes like "1.5 GB" from indexers,
+// which use binary units even when writing GB
+func parseSize(size string) (uint64, error) {
+	size = strings.TrimSpace(size)
+	if size == "" {
+		return 0, nil
+	}
+
+	for _, unit := range []string{"KB", "MB", "GB", "TB", "PB"} {
+		if strings.HasSuffix(strings.ToUpper(size), unit) {
+			size = size[:len(size)-len(unit)] + unit[:1] + "iB"
+			break
+		}
+	}
+
+	return humanize.ParseBytes(size)
+}
@@ -0,0 +1,26 @@
+package downloader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSize(t *testing.T) {
+	for in, want := range map[string]uint64{
+		"":       0,
+		"1.0 GB": 1 << 30,
+		"2.5 MB": 5 << 19,
+		"512 kb": 512 << 10,
+		"3 GiB":  3 << 30,
+		"100 B":  100,
+		"1.5 TB": 3 << 39,
+	} {
+		got, err := parseSize(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+
+	_, err := parseSize("lots")
+	require.Error(t, err)
+}
@@ -8,6 +8,8 @@ type ClientType int
 const (
 	ClientUnknown ClientType = iota
 	ClientTransmission
+	ClientQBittorrent
+	ClientHTTP
 )
 
 //go:generate go run github.com/dmarkham/enumer@latest -sql -type=DownloadState -output=enum_download_state.go
@@ -18,6 +20,8 @@ const (
 	Unknown DownloadState = iota
 	Queued
 	Downloading
+	// Extracting the download is complete and its archives are being unpacked
+	Extracting
 	Complete
 	Error
 )
@@ -32,6 +36,10 @@ type Download struct {
 	Complete uint64
 	Left     uint64
 
+	// Extracted and ExtractTotal track the archive extraction after a download completes
+	Extracted    uint64
+	ExtractTotal uint64
+
 	// Progress progressString contains any message from the client of the download
 	Progress string
 
@@ -43,6 +51,6 @@ type Download struct {
 // Downloader generic interface that any downloader must implement
 type Downloader interface {
 	Download(ctx context.Context, url string, downloadPath string) (downloadID string, err error)
-	Cancel(ctx context.Context, downloadId string, removeDownloaded bool) error
+	Cancel(ctx context.Context, download *Download, removeDownloaded bool) error
 	Progress(ctx context.Context, download *Download) (err error)
 }
@@ -12,6 +12,8 @@ func (g *Download) ToProto() *v1.Download {
 		Progress:     g.Progress,
 		Complete:     g.Complete,
 		Left:         g.Left,
+		Extracted:    g.Extracted,
+		ExtractTotal: g.ExtractTotal,
 		DownloadPath: g.DownloadPath,
 		DownloadUrl:  g.DownloadUrl,
 	}
@@ -8,11 +8,11 @@ import (
 	"strings"
 )
 
-const _ClientTypeName = "ClientUnknownClientTransmission"
+const _ClientTypeName = "ClientUnknownClientTransmissionClientQBittorrentClientHTTP"
 
-var _ClientTypeIndex = [...]uint8{0, 13, 31}
+var _ClientTypeIndex = [...]uint8{0, 13, 31, 48, 58}
 
-const _ClientTypeLowerName = "clientunknownclienttransmission"
+const _ClientTypeLowerName = "clientunknownclienttransmissionclientqbittorrentclienthttp"
 
 func (i ClientType) String() string {
 	if i < 0 || i >= ClientType(len(_ClientTypeIndex)-1) {
@@ -27,20 +27,28 @@ func _ClientTypeNoOp() {
 	var x [1]struct{}
 	_ = x[ClientUnknown-(0)]
 	_ = x[ClientTransmission-(1)]
+	_ = x[ClientQBittorrent-(2)]
+	_ = x[ClientHTTP-(3)]
 }
 
-var _ClientTypeValues = []ClientType{ClientUnknown, ClientTransmission}
+var _ClientTypeValues = []ClientType{ClientUnknown, ClientTransmission, ClientQBittorrent, ClientHTTP}
 
 var _ClientTypeNameToValueMap = map[string]ClientType{
 	_ClientTypeName[0:13]:       ClientUnknown,
 	_ClientTypeLowerName[0:13]:  ClientUnknown,
 	_ClientTypeName[13:31]:      ClientTransmission,
 	_ClientTypeLowerName[13:31]: ClientTransmission,
+	_ClientTypeName[31:48]:      ClientQBittorrent,
+	_ClientTypeLowerName[31:48]: ClientQBittorrent,
+	_ClientTypeName[48:58]:      ClientHTTP,
+	_ClientTypeLowerName[48:58]: ClientHTTP,
 }
 
 var _ClientTypeNames = []string{
 	_ClientTypeName[0:13],
 	_ClientTypeName[13:31],
+	_ClientTypeName[31:48],
+	_ClientTypeName[48:58],
 }
 
 // ClientTypeString retrieves an enum value from the enum constants string name.
@@ -8,11 +8,11 @@ import (
 	"strings"
 )
 
-const _DownloadStateName = "UnknownQueuedDownloadingCompleteError"
+const _DownloadStateName = "UnknownQueuedDownloadingExtractingCompleteError"
 
-var _DownloadStateIndex = [...]uint8{0, 7, 13, 24, 32, 37}
+var _DownloadStateIndex = [...]uint8{0, 7, 13, 24, 34, 42, 47}
 
-const _DownloadStateLowerName = "unknownqueueddownloadingcompleteerror"
+const _DownloadStateLowerName = "unknownqueueddownloadingextractingcompleteerror"
 
 func (i DownloadState) String() string {
 	if i < 0 || i >= DownloadState(len(_DownloadStateIndex)-1) {
@@ -28,11 +28,12 @@ func _DownloadStateNoOp() {
 	_ = x[Unknown-(0)]
 	_ = x[Queued-(1)]
 	_ = x[Downloading-(2)]
-	_ = x[Complete-(3)]
-	_ = x[Error-(4)]
+	_ = x[Extracting-(3)]
+	_ = x[Complete-(4)]
+	_ = x[Error-(5)]
 }
 
-var _DownloadStateValues = []DownloadState{Unknown, Queued, Downloading, Complete, Error}
+var _DownloadStateValues = []DownloadState{Unknown, Queued, Downloading, Extracting, Complete, Error}
 
 var _DownloadStateNameToValueMap = map[string]DownloadState{
 	_DownloadStateName[0:7]:        Unknown,
@@ -41,18 +42,21 @@ var _DownloadStateNameToValueMap = map[string]DownloadState{
 	_DownloadStateLowerName[7:13]:  Queued,
 	_DownloadStateName[13:24]:      Downloading,
 	_DownloadStateLowerName[13:24]: Downloading,
-	_DownloadStateName[24:32]:      Complete,
-	_DownloadStateLowerName[24:32]: Complete,
-	_DownloadStateName[32:37]:      Error,
-	_DownloadStateLowerName[32:37]: Error,
+	_DownloadStateName[24:34]:      Extracting,
+	_DownloadStateLowerName[24:34]: Extracting,
+	_DownloadStateName[34:42]:      Complete,
+	_DownloadStateLowerName[34:42]: Complete,
+	_DownloadStateName[42:47]:      Error,
+	_DownloadStateLowerName[42:47]: Error,
 }
 
 var _DownloadStateNames = []string{
 	_DownloadStateName[0:7],
 	_DownloadStateName[7:13],
 	_DownloadStateName[13:24],
-	_DownloadStateName[24:32],
-	_DownloadStateName[32:37],
+	_DownloadStateName[24:34],
+	_DownloadStateName[34:42],
+	_DownloadStateName[42:47],
 }
 
 // DownloadStateString retrieves an enum value from the enum constants string name.
@@ -3,12 +3,17 @@ package hydra
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"maps"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ra341/glacier/internal/indexer/types"
@@ -45,6 +50,9 @@ func (c Config) GetInterval() time.Duration {
 type Hydra struct {
 	config Config
 
+	indexMu sync.RWMutex
+	index   *searchIndex
+
 	cancel context.CancelFunc
 }
 
@@ -90,28 +98,64 @@ func (h *Hydra) Close() {
 // search stuff
 
 func (h *Hydra) Search(query string) ([]types.Source, error) {
-	index, err := h.loadIndex()
+	index, err := h.getIndex()
 	if err != nil {
 		return nil, err
 	}
 
-	var infos []types.Source
-	for _, d := range index.Downloads {
-		contains := strings.Contains(strings.ToLower(d.Title), strings.ToLower(query))
-		if contains {
-			infos = append(infos, types.Source{
-				IndexerType: types.IndexerHydra,
-				// todo allow from each source
-				GameType:    types.Installer,
-				Title:       d.Title,
-				DownloadUrl: d.Uris[0],
-				FileSize:    d.FileSize,
-				CreatedISO:  d.UploadDate.Format(time.RFC3339),
-			})
-		}
+	return index.search(query), nil
+}
+
+// getIndex returns the search index, building it from the cache on first use
+func (h *Hydra) getIndex() (*searchIndex, error) {
+	h.indexMu.RLock()
+	index := h.index
+	h.indexMu.RUnlock()
+
+	if index != nil {
+		return index, nil
+	}
+
+	return h.rebuildIndex()
+}
+
+// rebuildIndex reads the cached sources and swaps in a new search index
+func (h *Hydra) rebuildIndex() (*searchIndex, error) {
+	sources, err := h.loadIndex()
+	if err != nil {
+		return nil, err
 	}
 
-	return infos, nil
+	index := newSearchIndex(sources)
+
+	h.indexMu.Lock()
+	h.index = index
+	h.indexMu.Unlock()
+
+	log.Debug().
+		Str("indexer", types.IndexerHydra.String()).
+		Int("entries", len(index.entries)).
+		Msg("rebuilt search index")
+
+	return index, nil
+}
+
+// uniqueUris drops empty uris and the ones already returned by another result
+func uniqueUris(uris []string, seen map[string]struct{}) []string {
+	var unique []string
+	for _, uri := range uris {
+		uri = strings.TrimSpace(uri)
+		if uri == "" {
+			continue
+		}
+		if _, ok := seen[uri]; ok {
+			continue
+		}
+
+		seen[uri] = struct{}{}
+		unique = append(unique, uri)
+	}
+	return unique
 }
 
 type DownloadInfo struct {
@@ -122,29 +166,37 @@ type DownloadInfo struct {
 }
 
 type JsonResult struct {
+	// source name from the config, not part of the JSON
+	Name      string         `json:"-"`
 	Downloads []DownloadInfo `json:"downloads"`
 }
 
-func (h *Hydra) loadIndex() (*JsonResult, error) {
-	// todo search all files for now it just gets the first one in the map
+// loadIndex reads every cached source sorted by name,
+// sources that were not downloaded yet are skipped
+func (h *Hydra) loadIndex() ([]*JsonResult, error) {
+	names := slices.Sorted(maps.Keys(h.config.Sources))
 
-	for name, _ := range h.config.Sources {
-		path := h.getJsonPath(name)
-		contents, err := os.ReadFile(path)
+	var indexes []*JsonResult
+	for _, name := range names {
+		contents, err := os.ReadFile(h.getJsonPath(name))
 		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				log.Warn().Str("name", name).Msg("source is not downloaded yet, skipping")
+				continue
+			}
 			return nil, err
 		}
 
-		val := &JsonResult{}
+		val := &JsonResult{Name: name}
 		err = json.Unmarshal(contents, val)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("could not parse source %s: %w", name, err)
 		}
 
-		return val, nil
+		indexes = append(indexes, val)
 	}
 
-	return &JsonResult{}, nil
+	return indexes, nil
 }
 
 func (h *Hydra) withPath(name string) string {
@@ -170,6 +222,7 @@ func (h *Hydra) startIndexUpdater() {
 		select {
 		case <-timer.C:
 			h.updateIndexes()
+			timer.Reset(h.config.GetInterval())
 		case <-ctx.Done():
 			log.Info().
 				Str("indexer", types.IndexerHydra.String()).
@@ -184,18 +237,29 @@ func (h *Hydra) updateIndexes() {
 		Str("indexer", types.IndexerHydra.String()).
 		Msg("updating indexes")
 
+	changed := false
 	for name, url := range h.config.Sources {
-		err := h.downloadIndex(name, url)
+		modified, err := h.downloadIndex(name, url)
 		if err != nil {
 			log.Warn().Err(err).Str("name", name).Msg("failed to update index")
 		}
+		changed = changed || modified
+	}
+
+	if !changed {
+		return
+	}
+
+	if _, err := h.rebuildIndex(); err != nil {
+		log.Warn().Err(err).Msg("failed to rebuild search index")
 	}
 }
 
-func (h *Hydra) downloadIndex(name string, url string) error {
+// downloadIndex fetches the source JSON, reporting if it changed since the last download
+func (h *Hydra) downloadIndex(name string, url string) (bool, error) {
 	etag, err := h.loadEtag(name)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	get, err := resty.New().
@@ -206,11 +270,11 @@ func (h *Hydra) downloadIndex(name string, url string) error {
 		R().
 		Get(url)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if get.IsError() {
-		return fmt.Errorf(
+		return false, fmt.Errorf(
 			"could not get indexer %s\n code:%d \n%s",
 			name,
 			get.StatusCode(),
@@ -221,20 +285,20 @@ func (h *Hydra) downloadIndex(name string, url string) error {
 	newEtag := get.Header().Get("Etag")
 	if get.StatusCode() == http.StatusNotModified {
 		log.Debug().Msg("file unmodified")
-		return nil
+		return false, nil
 	}
 
 	log.Debug().Str("prev-etag", etag).Str("current-etag", newEtag).Msg("file modified")
 
 	if err = h.WriteJSON(name, get.Body); err != nil {
-		return err
+		return false, err
 	}
 
 	if err = h.writeEtag(name, newEtag); err != nil {
-		return err
+		return true, err
 	}
 
-	return nil
+	return true, nil
 }
 
 func (h *Hydra) WriteJSON(name string, writer io.Reader) error {
@@ -1,6 +1,7 @@
 package hydra
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -24,3 +25,43 @@ func TestHydra(t *testing.T) {
 
 	t.Log(search)
 }
+
+func TestSearchAllSources(t *testing.T) {
+	hydra, err := newRaw(map[string]any{
+		"cacheDir":       t.TempDir(),
+		"updateInterval": "24h",
+		"sources": map[string]string{
+			"fitgirl": "http://localhost/fitgirl.json",
+			"dodi":    "http://localhost/dodi.json",
+			// never downloaded
+			"missing": "http://localhost/missing.json",
+		},
+		"debug": false,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, hydra.WriteJSON("fitgirl", strings.NewReader(`{"downloads": [
+		{"title": "Warhammer 40,000: Space Marine", "uris": ["magnet:?xt=urn:btih:aaa", "magnet:?xt=urn:btih:aaa", "https://example.com/sm"]},
+		{"title": "Other Game", "uris": ["magnet:?xt=urn:btih:bbb"]}
+	]}`)))
+	require.NoError(t, hydra.WriteJSON("dodi", strings.NewReader(`{"downloads": [
+		{"title": "Warhammer: Vermintide 2", "uris": ["magnet:?xt=urn:btih:ccc"]},
+		{"title": "Warhammer 40,000: Space Marine", "uris": ["magnet:?xt=urn:btih:aaa"]},
+		{"title": "Warhammer no links", "uris": []}
+	]}`)))
+
+	results, err := hydra.Search("WARHAMMER")
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.Equal(t, "dodi", results[0].SourceName)
+	require.Equal(t, "Warhammer: Vermintide 2", results[0].Title)
+	require.Equal(t, "magnet:?xt=urn:btih:ccc", results[0].DownloadUrl)
+	require.Equal(t, "dodi", results[1].SourceName)
+	require.Equal(t, "magnet:?xt=urn:btih:aaa", results[1].DownloadUrl)
+
+	// dodi is read first, so fitgirl only keeps the uri dodi did not list
+	require.Equal(t, "fitgirl", results[2].SourceName)
+	require.Equal(t, []string{"https://example.com/sm"}, results[2].Uris)
+	require.Equal(t, "https://example.com/sm", results[2].DownloadUrl)
+}
@@ -0,0 +1,249 @@
+package hydra
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ra341/glacier/internal/indexer/types"
+)
+
+// in memory search index over every source, rebuilt when the sources are updated
+
+// match scores for a single query token
+const (
+	scoreExact  = 4
+	scorePrefix = 2
+	scoreFuzzy  = 1
+
+	// added when the title contains the query as written
+	scorePhrase = 3
+)
+
+type entry struct {
+	source types.Source
+	// normalized title, used for phrase matches
+	normalized string
+	tokens     int
+}
+
+type searchIndex struct {
+	entries []entry
+	// token -> index of the entries with the token in their title
+	postings map[string][]int
+	// every token sorted, prefix lookups binary search into it
+	vocab []string
+}
+
+// newSearchIndex builds the index from the sources in order,
+// uris already listed by an earlier entry are dropped
+func newSearchIndex(sources []*JsonResult) *searchIndex {
+	idx := &searchIndex{postings: map[string][]int{}}
+	seen := map[string]struct{}{}
+
+	for _, src := range sources {
+		for _, d := range src.Downloads {
+			uris := uniqueUris(d.Uris, seen)
+			if len(uris) == 0 {
+				continue
+			}
+
+			tokens := tokenize(d.Title)
+			if len(tokens) == 0 {
+				continue
+			}
+
+			id := len(idx.entries)
+			idx.entries = append(idx.entries, entry{
+				source: types.Source{
+					IndexerType: types.IndexerHydra,
+					// todo allow from each source
+					GameType:    types.Installer,
+					SourceName:  src.Name,
+					Title:       d.Title,
+					DownloadUrl: uris[0],
+					Uris:        uris,
+					FileSize:    d.FileSize,
+					CreatedISO:  d.UploadDate.Format(time.RFC3339),
+				},
+				normalized: strings.Join(tokens, " "),
+				tokens:     len(tokens),
+			})
+
+			for _, tok := range tokens {
+				ids := idx.postings[tok]
+				// titles can repeat a token
+				if len(ids) > 0 && ids[len(ids)-1] == id {
+					continue
+				}
+				idx.postings[tok] = append(ids, id)
+			}
+		}
+	}
+
+	idx.vocab = make([]string, 0, len(idx.postings))
+	for tok := range idx.postings {
+		idx.vocab = append(idx.vocab, tok)
+	}
+	slices.Sort(idx.vocab)
+
+	return idx
+}
+
+// search returns the entries matching every query token, best match first
+func (idx *searchIndex) search(query string) []types.Source {
+	queryTokens := tokenize(query)
+	if len(queryTokens) == 0 {
+		return nil
+	}
+
+	// entry -> score, entries missing a query token are dropped
+	var scores map[int]int
+	for _, qt := range queryTokens {
+		matched := map[int]int{}
+		for tok, score := range idx.matchToken(qt) {
+			for _, id := range idx.postings[tok] {
+				matched[id] = max(matched[id], score)
+			}
+		}
+
+		if scores == nil {
+			scores = matched
+			continue
+		}
+
+		for id, score := range scores {
+			if m, ok := matched[id]; ok {
+				scores[id] = score + m
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	phrase := strings.Join(queryTokens, " ")
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		if strings.Contains(idx.entries[id].normalized, phrase) {
+			scores[id] += scorePhrase
+		}
+		ids = append(ids, id)
+	}
+
+	slices.SortFunc(ids, func(a, b int) int {
+		if scores[a] != scores[b] {
+			return scores[b] - scores[a]
+		}
+		// fewer extra words ranks the base game above editions and bundles
+		ea, eb := idx.entries[a], idx.entries[b]
+		if ea.tokens != eb.tokens {
+			return ea.tokens - eb.tokens
+		}
+		// keep source order for ties
+		return a - b
+	})
+
+	results := make([]types.Source, 0, len(ids))
+	for _, id := range ids {
+		results = append(results, idx.entries[id].source)
+	}
+	return results
+}
+
+// matchToken finds the indexed tokens matching the query token with their score
+func (idx *searchIndex) matchToken(qt string) map[string]int {
+	matches := map[string]int{}
+
+	start, _ := slices.BinarySearch(idx.vocab, qt)
+	for _, tok := range idx.vocab[start:] {
+		if !strings.HasPrefix(tok, qt) {
+			break
+		}
+		if tok == qt {
+			matches[tok] = scoreExact
+		} else {
+			matches[tok] = scorePrefix
+		}
+	}
+
+	maxEdits := allowedEdits(qt)
+	if maxEdits == 0 {
+		return matches
+	}
+
+	for _, tok := range idx.vocab {
+		if _, ok := matches[tok]; ok {
+			continue
+		}
+		if withinDistance(qt, tok, maxEdits) {
+			matches[tok] = scoreFuzzy
+		}
+	}
+
+	return matches
+}
+
+// allowedEdits is the number of typos tolerated for a query token,
+// short tokens would match too much
+func allowedEdits(token string) int {
+	switch n := len([]rune(token)); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// tokenize lowercases the text and splits it into letters and digits,
+// apostrophes are dropped so "Baldur's" is a single token
+func tokenize(text string) []string {
+	text = strings.NewReplacer("'", "", "’", "").Replace(strings.ToLower(text))
+
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// withinDistance reports if the levenshtein distance of a and b is at most maxEdits
+func withinDistance(a, b string, maxEdits int) bool {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > maxEdits {
+		return false
+	}
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, cur[j])
+		}
+		// every later row is at least as large
+		if rowMin > maxEdits {
+			return false
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)] <= maxEdits
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,84 @@
+package hydra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndex(titles ...string) *searchIndex {
+	src := &JsonResult{Name: "test"}
+	for i, title := range titles {
+		src.Downloads = append(src.Downloads, DownloadInfo{
+			Title: title,
+			Uris:  []string{"magnet:" + string(rune('a'+i))},
+		})
+	}
+	return newSearchIndex([]*JsonResult{src})
+}
+
+func titles(t *testing.T, index *searchIndex, query string) []string {
+	t.Helper()
+	var res []string
+	for _, s := range index.search(query) {
+		res = append(res, s.Title)
+	}
+	return res
+}
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t,
+		[]string{"baldurs", "gate", "3", "digital", "deluxe", "edition"},
+		tokenize("Baldur's Gate 3: Digital Deluxe Edition"),
+	)
+	require.Equal(t, []string{"s", "t", "a", "l", "k", "e", "r", "2"}, tokenize("S.T.A.L.K.E.R. 2"))
+	require.Empty(t, tokenize(" - : "))
+}
+
+func TestSearchRanking(t *testing.T) {
+	index := newTestIndex(
+		"Hollow Knight: Voidheart Edition",
+		"Hollow Knight",
+		"Knights of the Old Republic",
+		"Silksong",
+	)
+
+	// shorter titles rank first on equal matches
+	require.Equal(t,
+		[]string{"Hollow Knight", "Hollow Knight: Voidheart Edition"},
+		titles(t, index, "hollow knight"),
+	)
+
+	// exact token matches outrank prefix matches
+	require.Equal(t,
+		[]string{"Hollow Knight", "Hollow Knight: Voidheart Edition", "Knights of the Old Republic"},
+		titles(t, index, "knight"),
+	)
+
+	// every query token has to match
+	require.Empty(t, titles(t, index, "hollow republic"))
+}
+
+func TestSearchPunctuationAndTypos(t *testing.T) {
+	index := newTestIndex(
+		"Warhammer 40,000: Space Marine 2",
+		"Baldur's Gate 3",
+		"Cyberpunk 2077: Phantom Liberty",
+	)
+
+	require.Equal(t, []string{"Warhammer 40,000: Space Marine 2"}, titles(t, index, "warhammer 40 000 space marine"))
+	require.Equal(t, []string{"Baldur's Gate 3"}, titles(t, index, "baldurs gate"))
+	require.Equal(t, []string{"Cyberpunk 2077: Phantom Liberty"}, titles(t, index, "cyberpnuk"))
+	require.Equal(t, []string{"Warhammer 40,000: Space Marine 2"}, titles(t, index, "warhamer"))
+
+	// short tokens are not fuzzy matched
+	require.Empty(t, titles(t, index, "gax"))
+}
+
+func TestWithinDistance(t *testing.T) {
+	require.True(t, withinDistance("kitten", "kitten", 0))
+	require.True(t, withinDistance("kitten", "sitten", 1))
+	require.False(t, withinDistance("kitten", "sitting", 2))
+	require.True(t, withinDistance("kitten", "sitting", 3))
+	require.False(t, withinDistance("a", "abcd", 2))
+}
@@ -0,0 +1,275 @@
+package torznab
+
+import (
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ra341/glacier/internal/indexer/types"
+	"github.com/ra341/glacier/pkg/mapsct"
+
+	"resty.dev/v3"
+)
+
+// queries a torznab or newznab api, like the ones exposed by prowlarr and jackett
+// https://torznab.github.io/spec-1.3-draft/torznab/Specification-v1.3.html
+
+type Config struct {
+	// full api endpoint, e.g. http://localhost:9696/1/api
+	URL    string
+	APIKey string
+	// newznab categories to search, 4000 is PC and 4050 is PC/Games,
+	// a parent category also allows its sub categories, empty searches PC
+	Categories []int
+	// max number of results requested from the api, 0 uses the api default
+	Limit int
+}
+
+const requestTimeout = 30 * time.Second
+
+// defaultCategory is PC, without a category the api also returns movies, tv and music
+const defaultCategory = 4000
+
+type Torznab struct {
+	config Config
+	cli    *resty.Client
+}
+
+func New(config map[string]any) (types.Indexer, error) {
+	var conf Config
+
+	err := mapsct.ParseMap(&conf, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.URL == "" {
+		return nil, fmt.Errorf("torznab url is required")
+	}
+	if len(conf.Categories) == 0 {
+		conf.Categories = []int{defaultCategory}
+	}
+
+	return &Torznab{
+		config: conf,
+		cli:    resty.New().SetTimeout(requestTimeout),
+	}, nil
+}
+
+func (t *Torznab) Close() {
+	_ = t.cli.Close()
+}
+
+func (t *Torznab) Search(query string) ([]types.Source, error) {
+	params := map[string]string{
+		"t":      "search",
+		"q":      query,
+		"apikey": t.config.APIKey,
+	}
+	cats := make([]string, 0, len(t.config.Categories))
+	for _, c := range t.config.Categories {
+		cats = append(cats, strconv.Itoa(c))
+	}
+	params["cat"] = strings.Join(cats, ",")
+	if t.config.Limit > 0 {
+		params["limit"] = strconv.Itoa(t.config.Limit)
+	}
+
+	res, err := t.cli.R().
+		SetQueryParams(params).
+		Get(t.config.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.IsError() {
+		return nil, fmt.Errorf("torznab search failed code:%d %s", res.StatusCode(), res.String())
+	}
+
+	return t.parse(res.Bytes())
+}
+
+type rss struct {
+	Channel struct {
+		Items []item `xml:"item"`
+	} `xml:"channel"`
+}
+
+// apiError is returned instead of the rss feed, usually with a 200
+type apiError struct {
+	XMLName     xml.Name `xml:"error"`
+	Code        string   `xml:"code,attr"`
+	Description string   `xml:"description,attr"`
+}
+
+type item struct {
+	Title     string `xml:"title"`
+	Link      string `xml:"link"`
+	Size      int64  `xml:"size"`
+	PubDate   string `xml:"pubDate"`
+	Enclosure struct {
+		URL    string `xml:"url,attr"`
+		Length int64  `xml:"length,attr"`
+	} `xml:"enclosure"`
+	Categories []string `xml:"category"`
+	// torznab:attr and newznab:attr, matched by local name
+	Attrs []attr `xml:"attr"`
+}
+
+type attr struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+func (t *Torznab) parse(body []byte) ([]types.Source, error) {
+	var apiErr apiError
+	if xml.Unmarshal(body, &apiErr) == nil {
+		return nil, fmt.Errorf("torznab error code:%s %s", apiErr.Code, apiErr.Description)
+	}
+
+	var feed rss
+	err := xml.Unmarshal(body, &feed)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse torznab response: %w", err)
+	}
+
+	var sources []types.Source
+	for _, it := range feed.Channel.Items {
+		if !t.allowed(it.categories()) {
+			continue
+		}
+
+		url := it.downloadUrl()
+		if url == "" {
+			continue
+		}
+
+		src := types.Source{
+			IndexerType: types.IndexerTorznab,
+			GameType:    types.Installer,
+			Title:       it.Title,
+			DownloadUrl: url,
+			Uris:        it.uris(),
+			FileSize:    formatSize(it.size()),
+			Seeders:     it.seeders(),
+		}
+		if pub, err := time.Parse(time.RFC1123Z, it.PubDate); err == nil {
+			src.CreatedISO = pub.Format(time.RFC3339)
+		}
+
+		sources = append(sources, src)
+	}
+
+	return sources, nil
+}
+
+// allowed reports if one of the item categories is in the configured ones,
+// items without categories are kept since the api already filtered them
+func (t *Torznab) allowed(categories []int) bool {
+	if len(categories) == 0 {
+		return true
+	}
+
+	for _, cat := range categories {
+		for _, want := range t.config.Categories {
+			// parent categories are multiples of 1000
+			if cat == want || (want%1000 == 0 && cat/1000 == want/1000) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (it *item) attr(name string) (string, bool) {
+	for _, a := range it.Attrs {
+		if a.Name == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+func (it *item) categories() []int {
+	var cats []int
+	add := func(val string) {
+		cat, err := strconv.Atoi(strings.TrimSpace(val))
+		if err == nil && !slices.Contains(cats, cat) {
+			cats = append(cats, cat)
+		}
+	}
+
+	for _, a := range it.Attrs {
+		if a.Name == "category" {
+			add(a.Value)
+		}
+	}
+	for _, c := range it.Categories {
+		add(c)
+	}
+	return cats
+}
+
+// downloadUrl prefers the magnet so no torrent file has to be fetched from the indexer
+func (it *item) downloadUrl() string {
+	if magnet, ok := it.attr("magneturl"); ok && magnet != "" {
+		return magnet
+	}
+	if it.Enclosure.URL != "" {
+		return it.Enclosure.URL
+	}
+	return it.Link
+}
+
+func (it *item) uris() []string {
+	var uris []string
+	for _, uri := range []string{it.downloadUrl(), it.Enclosure.URL, it.Link} {
+		if uri != "" && !slices.Contains(uris, uri) {
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
+
+func (it *item) size() int64 {
+	if val, ok := it.attr("size"); ok {
+		if size, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return size
+		}
+	}
+	if it.Size > 0 {
+		return it.Size
+	}
+	return it.Enclosure.Length
+}
+
+func (it *item) seeders() uint32 {
+	val, ok := it.attr("seeders")
+	if !ok {
+		return 0
+	}
+
+	seeders, err := strconv.ParseUint(val, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(seeders)
+}
+
+func formatSize(size int64) string {
+	if size <= 0 {
+		return ""
+	}
+
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	val := float64(size)
+	unit := 0
+	for val >= 1024 && unit < len(units)-1 {
+		val /= 1024
+		unit++
+	}
+	return fmt.Sprintf("%.1f %s", val, units[unit])
+}
@@ -0,0 +1,123 @@
+package torznab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ra341/glacier/internal/indexer/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:torznab="http://torznab.com/schemas/2015/feed">
+  <channel>
+    <title>Prowlarr</title>
+    <item>
+      <title>Hollow Knight v1.5.78-GOG</title>
+      <guid>https://tracker.example/details/1</guid>
+      <link>https://prowlarr.example/1/download?id=1</link>
+      <pubDate>Tue, 07 Oct 2025 18:30:00 +0000</pubDate>
+      <size>1073741824</size>
+      <enclosure url="https://prowlarr.example/1/download?id=1" length="1073741824" type="application/x-bittorrent" />
+      <torznab:attr name="category" value="4050" />
+      <torznab:attr name="seeders" value="42" />
+      <torznab:attr name="magneturl" value="magnet:?xt=urn:btih:aaaa" />
+    </item>
+    <item>
+      <title>Hollow Knight OST FLAC</title>
+      <link>https://prowlarr.example/1/download?id=2</link>
+      <pubDate>Tue, 07 Oct 2025 18:30:00 +0000</pubDate>
+      <torznab:attr name="category" value="3040" />
+      <torznab:attr name="seeders" value="5" />
+    </item>
+    <item>
+      <title>Hollow Knight Mac</title>
+      <link>https://prowlarr.example/1/download?id=3</link>
+      <pubDate>Tue, 07 Oct 2025 18:30:00 +0000</pubDate>
+      <torznab:attr name="category" value="4030" />
+      <torznab:attr name="size" value="2048" />
+    </item>
+  </channel>
+</rss>`
+
+func newFakeTorznab(t *testing.T, body string) (*httptest.Server, *url.Values) {
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &query
+}
+
+func newTestIndexer(t *testing.T, srv *httptest.Server, categories []int) *Torznab {
+	idx, err := New(map[string]any{
+		"url":        srv.URL + "/1/api",
+		"apiKey":     "secret",
+		"categories": categories,
+		"limit":      50,
+	})
+	require.NoError(t, err)
+	t.Cleanup(idx.Close)
+	return idx.(*Torznab)
+}
+
+func TestSearch(t *testing.T) {
+	srv, query := newFakeTorznab(t, testFeed)
+	idx := newTestIndexer(t, srv, []int{4050})
+
+	results, err := idx.Search("hollow knight")
+	require.NoError(t, err)
+
+	require.Equal(t, "search", query.Get("t"))
+	require.Equal(t, "hollow knight", query.Get("q"))
+	require.Equal(t, "secret", query.Get("apikey"))
+	require.Equal(t, "4050", query.Get("cat"))
+	require.Equal(t, "50", query.Get("limit"))
+
+	require.Len(t, results, 1)
+	res := results[0]
+	require.Equal(t, types.IndexerTorznab, res.IndexerType)
+	require.Equal(t, "Hollow Knight v1.5.78-GOG", res.Title)
+	require.Equal(t, "magnet:?xt=urn:btih:aaaa", res.DownloadUrl)
+	require.Equal(t, []string{"magnet:?xt=urn:btih:aaaa", "https://prowlarr.example/1/download?id=1"}, res.Uris)
+	require.Equal(t, "1.0 GB", res.FileSize)
+	require.Equal(t, uint32(42), res.Seeders)
+	require.Equal(t, "2025-10-07T18:30:00Z", res.CreatedISO)
+}
+
+func TestSearchParentCategory(t *testing.T) {
+	srv, query := newFakeTorznab(t, testFeed)
+	idx := newTestIndexer(t, srv, []int{4000})
+
+	results, err := idx.Search("hollow knight")
+	require.NoError(t, err)
+	require.Equal(t, "4000", query.Get("cat"))
+
+	require.Len(t, results, 2)
+	// falls back to the link without a magnet or enclosure
+	require.Equal(t, "https://prowlarr.example/1/download?id=3", results[1].DownloadUrl)
+	require.Equal(t, "2.0 KB", results[1].FileSize)
+}
+
+func TestSearchDefaultCategory(t *testing.T) {
+	srv, query := newFakeTorznab(t, testFeed)
+	idx := newTestIndexer(t, srv, nil)
+
+	results, err := idx.Search("hollow knight")
+	require.NoError(t, err)
+	require.Equal(t, "4000", query.Get("cat"))
+	require.Len(t, results, 2)
+}
+
+func TestSearchApiError(t *testing.T) {
+	srv, _ := newFakeTorznab(t, `<?xml version="1.0" encoding="UTF-8"?><error code="100" description="Invalid API Key" />`)
+	idx := newTestIndexer(t, srv, []int{4000})
+
+	_, err := idx.Search("hollow knight")
+	require.ErrorContains(t, err, "Invalid API Key")
+}
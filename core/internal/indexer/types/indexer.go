@@ -26,6 +26,7 @@ type IndexerType int
 const (
 	IndexerUnknown IndexerType = iota
 	IndexerHydra
+	IndexerTorznab
 )
 
 type Indexer interface {
@@ -41,14 +42,20 @@ type Indexer interface {
 type Source struct {
 	IndexerType IndexerType
 	GameType    GameType
+	// which list of the indexer the result came from, e.g. the hydra source
+	SourceName string
 
 	Title       string
 	DownloadUrl string
+	// every uri for the result, DownloadUrl is the first one
+	Uris []string `gorm:"serializer:json"`
 
 	// optional
 	ImageURL   string
 	FileSize   string
 	CreatedISO string
+	// only set by torrent indexers
+	Seeders uint32
 }
 
 type DownloadInfo struct {
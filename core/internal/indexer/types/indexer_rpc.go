@@ -9,11 +9,14 @@ func (ig *Source) ToProto() *v1.GameSource {
 	return &v1.GameSource{
 		IndexerType: ig.IndexerType.String(),
 		GameType:    ig.GameType.String(),
+		SourceName:  ig.SourceName,
 		Title:       ig.Title,
 		DownloadUrl: ig.DownloadUrl,
+		Uris:        ig.Uris,
 		ImageURL:    ig.ImageURL,
 		FileSize:    ig.FileSize,
 		CreatedISO:  ig.CreatedISO,
+		Seeders:     ig.Seeders,
 	}
 }
 
@@ -32,9 +35,12 @@ func (ig *Source) FromProto(rpcGame *v1.GameSource) {
 
 	ig.IndexerType = indexerType
 	ig.GameType = gameType
+	ig.SourceName = rpcGame.SourceName
 	ig.Title = rpcGame.Title
 	ig.DownloadUrl = rpcGame.DownloadUrl
+	ig.Uris = rpcGame.Uris
 	ig.ImageURL = rpcGame.ImageURL
 	ig.FileSize = rpcGame.FileSize
 	ig.CreatedISO = rpcGame.CreatedISO
+	ig.Seeders = rpcGame.Seeders
 }
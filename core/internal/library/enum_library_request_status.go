@@ -0,0 +1,113 @@
+// Code generated by "enumer -sql -type=RequestStatus -trimprefix=Request -output=enum_library_request_status.go"; DO NOT EDIT.
+
+package library
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+const _RequestStatusName = "PendingApprovedDenied"
+
+var _RequestStatusIndex = [...]uint8{0, 7, 15, 21}
+
+const _RequestStatusLowerName = "pendingapproveddenied"
+
+func (i RequestStatus) String() string {
+	if i < 0 || i >= RequestStatus(len(_RequestStatusIndex)-1) {
+		return fmt.Sprintf("RequestStatus(%d)", i)
+	}
+	return _RequestStatusName[_RequestStatusIndex[i]:_RequestStatusIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _RequestStatusNoOp() {
+	var x [1]struct{}
+	_ = x[RequestPending-(0)]
+	_ = x[RequestApproved-(1)]
+	_ = x[RequestDenied-(2)]
+}
+
+var _RequestStatusValues = []RequestStatus{RequestPending, RequestApproved, RequestDenied}
+
+var _RequestStatusNameToValueMap = map[string]RequestStatus{
+	_RequestStatusName[0:7]:        RequestPending,
+	_RequestStatusLowerName[0:7]:   RequestPending,
+	_RequestStatusName[7:15]:       RequestApproved,
+	_RequestStatusLowerName[7:15]:  RequestApproved,
+	_RequestStatusName[15:21]:      RequestDenied,
+	_RequestStatusLowerName[15:21]: RequestDenied,
+}
+
+var _RequestStatusNames = []string{
+	_RequestStatusName[0:7],
+	_RequestStatusName[7:15],
+	_RequestStatusName[15:21],
+}
+
+// RequestStatusString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func RequestStatusString(s string) (RequestStatus, error) {
+	if val, ok := _RequestStatusNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _RequestStatusNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to RequestStatus values", s)
+}
+
+// RequestStatusValues returns all values of the enum
+func RequestStatusValues() []RequestStatus {
+	return _RequestStatusValues
+}
+
+// RequestStatusStrings returns a slice of all String values of the enum
+func RequestStatusStrings() []string {
+	strs := make([]string, len(_RequestStatusNames))
+	copy(strs, _RequestStatusNames)
+	return strs
+}
+
+// IsARequestStatus returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i RequestStatus) IsARequestStatus() bool {
+	for _, v := range _RequestStatusValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (i RequestStatus) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+func (i *RequestStatus) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of RequestStatus: %[1]T(%[1]v)", value)
+	}
+
+	val, err := RequestStatusString(str)
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
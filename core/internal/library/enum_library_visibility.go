@@ -0,0 +1,109 @@
+// Code generated by "enumer -sql -type=Visibility -output=enum_library_visibility.go"; DO NOT EDIT.
+
+package library
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+const _VisibilityName = "PublicPrivate"
+
+var _VisibilityIndex = [...]uint8{0, 6, 13}
+
+const _VisibilityLowerName = "publicprivate"
+
+func (i Visibility) String() string {
+	if i < 0 || i >= Visibility(len(_VisibilityIndex)-1) {
+		return fmt.Sprintf("Visibility(%d)", i)
+	}
+	return _VisibilityName[_VisibilityIndex[i]:_VisibilityIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _VisibilityNoOp() {
+	var x [1]struct{}
+	_ = x[Public-(0)]
+	_ = x[Private-(1)]
+}
+
+var _VisibilityValues = []Visibility{Public, Private}
+
+var _VisibilityNameToValueMap = map[string]Visibility{
+	_VisibilityName[0:6]:       Public,
+	_VisibilityLowerName[0:6]:  Public,
+	_VisibilityName[6:13]:      Private,
+	_VisibilityLowerName[6:13]: Private,
+}
+
+var _VisibilityNames = []string{
+	_VisibilityName[0:6],
+	_VisibilityName[6:13],
+}
+
+// VisibilityString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func VisibilityString(s string) (Visibility, error) {
+	if val, ok := _VisibilityNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _VisibilityNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Visibility values", s)
+}
+
+// VisibilityValues returns all values of the enum
+func VisibilityValues() []Visibility {
+	return _VisibilityValues
+}
+
+// VisibilityStrings returns a slice of all String values of the enum
+func VisibilityStrings() []string {
+	strs := make([]string, len(_VisibilityNames))
+	copy(strs, _VisibilityNames)
+	return strs
+}
+
+// IsAVisibility returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Visibility) IsAVisibility() bool {
+	for _, v := range _VisibilityValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (i Visibility) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+func (i *Visibility) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of Visibility: %[1]T(%[1]v)", value)
+	}
+
+	val, err := VisibilityString(str)
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
@@ -2,11 +2,14 @@ package library
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 	v1 "github.com/ra341/glacier/generated/library/v1"
 	"github.com/ra341/glacier/generated/library/v1/v1connect"
+	indexerTypes "github.com/ra341/glacier/internal/indexer/types"
 	"github.com/ra341/glacier/internal/metadata/types"
 	"github.com/ra341/glacier/pkg/listutils"
 )
@@ -36,7 +39,11 @@ func (h *Handler) ListWithState(ctx context.Context, c *connect.Request[v1.ListW
 }
 
 func (h *Handler) List(ctx context.Context, c *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error) {
-	list, err := h.srv.List(ctx, c.Msg.Query, uint(c.Msg.Offset), uint(c.Msg.Limit))
+	filter := ListFilter{
+		OwnedOnly:     c.Msg.OwnedOnly,
+		IncludeHidden: c.Msg.IncludeHidden,
+	}
+	list, err := h.srv.List(ctx, c.Msg.Query, filter, uint(c.Msg.Offset), uint(c.Msg.Limit))
 	if err != nil {
 		return nil, err
 	}
@@ -64,13 +71,163 @@ func (h *Handler) TriggerTracker(ctx context.Context, req *connect.Request[v1.Tr
 	return connect.NewResponse(&v1.TriggerTrackerResponse{}), nil
 }
 
+func (h *Handler) WatchDownloads(ctx context.Context, req *connect.Request[v1.WatchDownloadsRequest], stream *connect.ServerStream[v1.WatchDownloadsResponse]) error {
+	wanted := make(map[uint]bool, len(req.Msg.GameIds))
+	for _, id := range req.Msg.GameIds {
+		wanted[uint(id)] = true
+	}
+
+	sub := h.srv.WatchDownloads()
+	defer sub.Close()
+
+	// game id -> visible to the user of the stream, checked once per game
+	visible := map[uint]bool{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-sub.C:
+			if dropped := sub.Dropped(); dropped > 0 {
+				err := stream.Send(&v1.WatchDownloadsResponse{Dropped: dropped})
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(wanted) > 0 && !wanted[event.GameId] {
+				continue
+			}
+			if _, checked := visible[event.GameId]; !checked {
+				_, err := h.srv.Get(ctx, event.GameId)
+				visible[event.GameId] = err == nil
+			}
+			if !visible[event.GameId] {
+				continue
+			}
+
+			err := stream.Send(&v1.WatchDownloadsResponse{
+				GameId:   uint64(event.GameId),
+				Download: event.Download.ToProto(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *Handler) ReportPlaytime(ctx context.Context, req *connect.Request[v1.ReportPlaytimeRequest]) (*connect.Response[v1.ReportPlaytimeResponse], error) {
+	playedAt := time.Now()
+	if req.Msg.PlayedAt != "" {
+		var err error
+		playedAt, err = time.Parse(time.RFC3339, req.Msg.PlayedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid played at time: %w", err)
+		}
+	}
+
+	err := h.srv.ReportPlaytime(ctx, uint(req.Msg.GameId), req.Msg.Seconds, playedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ReportPlaytimeResponse{}), nil
+}
+
+func (h *Handler) ListPlaytime(ctx context.Context, req *connect.Request[v1.ListPlaytimeRequest]) (*connect.Response[v1.ListPlaytimeResponse], error) {
+	playtime, err := h.srv.ListPlaytime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := listutils.ToMap(playtime, func(t Playtime) *v1.Playtime {
+		return t.ToProto()
+	})
+
+	return connect.NewResponse(&v1.ListPlaytimeResponse{
+		Playtime: res,
+	}), nil
+}
+
+func (h *Handler) ListCollection(ctx context.Context, req *connect.Request[v1.ListCollectionRequest]) (*connect.Response[v1.ListCollectionResponse], error) {
+	collection, err := h.srv.ListCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := listutils.ToMap(collection, func(t UserGame) *v1.CollectionEntry {
+		return t.ToProto()
+	})
+
+	return connect.NewResponse(&v1.ListCollectionResponse{
+		Entries: res,
+	}), nil
+}
+
+func (h *Handler) SetCollection(ctx context.Context, req *connect.Request[v1.SetCollectionRequest]) (*connect.Response[v1.SetCollectionResponse], error) {
+	entry := req.Msg.GetEntry()
+	if entry == nil {
+		return nil, fmt.Errorf("no collection entry specified")
+	}
+
+	err := h.srv.SetCollection(ctx, uint(entry.GameId), entry.Owned, entry.Hidden)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetCollectionResponse{}), nil
+}
+
+func (h *Handler) GetAccess(ctx context.Context, req *connect.Request[v1.GetAccessRequest]) (*connect.Response[v1.GetAccessResponse], error) {
+	visibility, access, err := h.srv.GetAccess(ctx, uint(req.Msg.GameId))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.GetAccessResponse{
+		Visibility: visibility.String(),
+	}
+	for _, a := range access {
+		if a.Role != "" {
+			res.Roles = append(res.Roles, a.Role)
+		} else {
+			res.UserIds = append(res.UserIds, uint64(a.UserId))
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (h *Handler) SetAccess(ctx context.Context, req *connect.Request[v1.SetAccessRequest]) (*connect.Response[v1.SetAccessResponse], error) {
+	visibility, err := VisibilityString(req.Msg.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	var access []GameAccess
+	for _, id := range req.Msg.UserIds {
+		access = append(access, GameAccess{UserId: uint(id)})
+	}
+	for _, role := range req.Msg.Roles {
+		access = append(access, GameAccess{Role: role})
+	}
+
+	err = h.srv.SetAccess(ctx, uint(req.Msg.GameId), visibility, access)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetAccessResponse{}), nil
+}
+
 func (h *Handler) Exists(ctx context.Context, req *connect.Request[v1.ExistsRequest]) (*connect.Response[v1.ExistsResponse], error) {
 	typeString, err := types.ProviderTypeString(req.Msg.MetadataType)
 	if err != nil {
 		return nil, err
 	}
 	
-	gameId, err := h.srv.store.Exists(typeString, req.Msg.MetadataGameId)
+	gameId, err := h.srv.Exists(ctx, typeString, req.Msg.MetadataGameId)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +248,75 @@ func (h *Handler) GetGame(ctx context.Context, c *connect.Request[v1.GetGameRequ
 	}), nil
 }
 
+func (h *Handler) RequestGame(ctx context.Context, req *connect.Request[v1.RequestGameRequest]) (*connect.Response[v1.RequestGameResponse], error) {
+	if req.Msg.Meta == nil {
+		return nil, fmt.Errorf("metadata is required")
+	}
+	var meta types.Meta
+	meta.FromProto(req.Msg.Meta)
+
+	gameReq, err := h.srv.Request(ctx, &meta, req.Msg.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.RequestGameResponse{Request: gameReq.ToProto()}), nil
+}
+
+func (h *Handler) ListRequests(ctx context.Context, req *connect.Request[v1.ListRequestsRequest]) (*connect.Response[v1.ListRequestsResponse], error) {
+	var status *RequestStatus
+	if req.Msg.Status != "" {
+		parsed, err := RequestStatusString(req.Msg.Status)
+		if err != nil {
+			return nil, err
+		}
+		status = &parsed
+	}
+
+	requests, err := h.srv.ListRequests(ctx, req.Msg.All, status)
+	if err != nil {
+		return nil, err
+	}
+
+	res := listutils.ToMap(requests, func(t GameRequest) *v1.GameRequest {
+		return t.ToProto()
+	})
+	return connect.NewResponse(&v1.ListRequestsResponse{Requests: res}), nil
+}
+
+func (h *Handler) ApproveRequest(ctx context.Context, req *connect.Request[v1.ApproveRequestRequest]) (*connect.Response[v1.ApproveRequestResponse], error) {
+	if req.Msg.Source == nil {
+		return nil, fmt.Errorf("source is required")
+	}
+	var source indexerTypes.Source
+	source.FromProto(req.Msg.Source)
+
+	gameReq, err := h.srv.ApproveRequest(ctx, uint(req.Msg.Id), &source)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ApproveRequestResponse{Request: gameReq.ToProto()}), nil
+}
+
+func (h *Handler) DenyRequest(ctx context.Context, req *connect.Request[v1.DenyRequestRequest]) (*connect.Response[v1.DenyRequestResponse], error) {
+	gameReq, err := h.srv.DenyRequest(ctx, uint(req.Msg.Id), req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.DenyRequestResponse{Request: gameReq.ToProto()}), nil
+}
+
+func (h *Handler) CancelRequest(ctx context.Context, req *connect.Request[v1.CancelRequestRequest]) (*connect.Response[v1.CancelRequestResponse], error) {
+	err := h.srv.CancelRequest(ctx, uint(req.Msg.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CancelRequestResponse{}), nil
+}
+
 func (h *Handler) Add(ctx context.Context, req *connect.Request[v1.AddRequest]) (*connect.Response[v1.AddResponse], error) {
 	var game = &Game{}
 	game.FromProto(req.Msg.Game)
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/ra341/glacier/internal/user"
 	"github.com/ra341/glacier/pkg/fileutil"
 )
 
@@ -34,8 +35,16 @@ func (h *HandlerHttp) getMetadata(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "could not convert id to uint", http.StatusBadRequest)
 		return
 	}
+	if !h.canSee(r, gid) {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return
+	}
+
+	contentType := NegotiateManifest(r.Header.Get("Accept"))
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Add("Vary", "Accept")
 
-	err = h.srv.manifest.GetDownloadManifest(r.Context(), gid, w)
+	err = h.srv.manifest.GetDownloadManifest(r.Context(), gid, w, contentType)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -53,6 +62,10 @@ func (h *HandlerHttp) downloadFile(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "could not convert id to uint", http.StatusBadRequest)
 		return
 	}
+	if !h.canSee(r, gameId) {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return
+	}
 
 	file := r.PathValue("file")
 	if file == "" {
@@ -75,3 +88,14 @@ func (h *HandlerHttp) downloadFile(w http.ResponseWriter, r *http.Request) {
 
 	http.ServeContent(w, r, download.Name(), stat.ModTime(), download)
 }
+
+// canSee checks the user of the request can download the game, private games are not found for others
+func (h *HandlerHttp) canSee(r *http.Request, gameId int) bool {
+	_, err := user.Authorize(r.Context(), user.PermDownloadFrost)
+	if err != nil {
+		return false
+	}
+
+	_, err = h.srv.Get(r.Context(), uint(gameId))
+	return err == nil
+}
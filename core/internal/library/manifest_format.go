@@ -0,0 +1,159 @@
+package library
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"time"
+
+	v1 "github.com/ra341/glacier/generated/library/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ManifestVersion is the version of the manifest format written by this build,
+// bump it on breaking changes to v1.Manifest
+const ManifestVersion = 1
+
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+	// ContentTypeGob is only kept for frost builds older than the versioned manifest
+	ContentTypeGob = "application/x-gob"
+)
+
+// NegotiateManifest picks the manifest encoding from the Accept header in the client's order,
+// requests without one come from older frost builds that expect gob
+func NegotiateManifest(accept string) string {
+	if strings.TrimSpace(accept) == "" {
+		return ContentTypeGob
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case ContentTypeProtobuf, "application/protobuf":
+			return ContentTypeProtobuf
+		case ContentTypeJSON, "*/*", "application/*":
+			return ContentTypeJSON
+		case ContentTypeGob:
+			return ContentTypeGob
+		}
+	}
+
+	return ContentTypeJSON
+}
+
+// EncodeManifest writes the manifest in the content type from NegotiateManifest
+func EncodeManifest(w io.Writer, contentType string, meta *FolderManifest) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch contentType {
+	case ContentTypeGob:
+		return gob.NewEncoder(w).Encode(meta)
+	case ContentTypeProtobuf:
+		data, err = proto.Marshal(meta.ToProto())
+	case ContentTypeJSON:
+		data, err = protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(meta.ToProto())
+	default:
+		return fmt.Errorf("unsupported manifest content type: %s", contentType)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// DecodeManifest reads a manifest from a response with the given Content-Type,
+// servers older than the versioned manifest always send gob
+func DecodeManifest(r io.Reader, contentType string) (FolderManifest, error) {
+	var meta FolderManifest
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != ContentTypeProtobuf && mediaType != ContentTypeJSON {
+		err := gob.NewDecoder(r).Decode(&meta)
+		return meta, err
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return meta, err
+	}
+
+	var rpcMeta v1.Manifest
+	if mediaType == ContentTypeProtobuf {
+		err = proto.Unmarshal(data, &rpcMeta)
+	} else {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, &rpcMeta)
+	}
+	if err != nil {
+		return meta, fmt.Errorf("could not decode manifest: %w", err)
+	}
+
+	err = meta.FromProto(&rpcMeta)
+	return meta, err
+}
+
+func (m *FolderManifest) ToProto() *v1.Manifest {
+	files := make([]*v1.FileManifest, 0, len(m.FileInfo))
+	for _, fm := range m.FileInfo {
+		files = append(files, &v1.FileManifest{
+			RelPath:   fm.RelPath,
+			Size:      fm.Size,
+			ModTime:   fm.ModTime.Format(time.RFC3339Nano),
+			Checksum:  fm.Checksum,
+			BlockSize: fm.BlockSize,
+			Blocks:    fm.Blocks,
+		})
+	}
+
+	return &v1.Manifest{
+		Version:   ManifestVersion,
+		GameId:    uint64(m.GameID),
+		TotalSize: m.TotalSize,
+		Files:     files,
+	}
+}
+
+func (m *FolderManifest) FromProto(rpcMeta *v1.Manifest) error {
+	if rpcMeta.Version == 0 || rpcMeta.Version > ManifestVersion {
+		return fmt.Errorf(
+			"unsupported manifest version %d, this build reads up to %d",
+			rpcMeta.Version, ManifestVersion,
+		)
+	}
+
+	m.GameID = int(rpcMeta.GameId)
+	m.TotalSize = rpcMeta.TotalSize
+	m.FileInfo = make([]FileManifest, 0, len(rpcMeta.Files))
+
+	for _, f := range rpcMeta.Files {
+		modTime, err := time.Parse(time.RFC3339Nano, f.ModTime)
+		if err != nil {
+			return fmt.Errorf("invalid mod time for %s: %w", f.RelPath, err)
+		}
+
+		m.FileInfo = append(m.FileInfo, FileManifest{
+			RelPath:   f.RelPath,
+			Size:      f.Size,
+			ModTime:   modTime,
+			Checksum:  f.Checksum,
+			BlockSize: f.BlockSize,
+			Blocks:    f.Blocks,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package library
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	v1 "github.com/ra341/glacier/generated/library/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNegotiateManifest(t *testing.T) {
+	cases := map[string]string{
+		"":                                       ContentTypeGob,
+		"application/x-protobuf":                 ContentTypeProtobuf,
+		"application/json; charset=utf-8":        ContentTypeJSON,
+		"text/html, application/x-gob":           ContentTypeGob,
+		"text/html,*/*;q=0.8":                    ContentTypeJSON,
+		"application/protobuf, application/json": ContentTypeProtobuf,
+		"text/plain":                             ContentTypeJSON,
+	}
+
+	for accept, want := range cases {
+		require.Equal(t, want, NegotiateManifest(accept), accept)
+	}
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	meta := FolderManifest{
+		GameID:    7,
+		TotalSize: 12,
+		FileInfo: []FileManifest{
+			{
+				RelPath:   "data/level1.pak",
+				Size:      12,
+				ModTime:   time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+				Checksum:  "123",
+				BlockSize: 4,
+				Blocks:    []string{"1", "2", "3"},
+			},
+		},
+	}
+
+	for _, contentType := range []string{ContentTypeProtobuf, ContentTypeJSON, ContentTypeGob} {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeManifest(&buf, contentType, &meta))
+
+		decoded, err := DecodeManifest(&buf, contentType)
+		require.NoError(t, err, contentType)
+		require.Equal(t, meta.GameID, decoded.GameID, contentType)
+		require.Equal(t, meta.TotalSize, decoded.TotalSize, contentType)
+		require.Len(t, decoded.FileInfo, 1)
+		require.True(t, meta.FileInfo[0].ModTime.Equal(decoded.FileInfo[0].ModTime), contentType)
+
+		decoded.FileInfo[0].ModTime = meta.FileInfo[0].ModTime
+		require.Equal(t, meta.FileInfo, decoded.FileInfo, contentType)
+	}
+}
+
+func TestManifestJsonFields(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeManifest(&buf, ContentTypeJSON, &FolderManifest{}))
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fields))
+	require.Equal(t, float64(ManifestVersion), fields["Version"])
+	require.Contains(t, fields, "Files")
+}
+
+func TestManifestRejectsNewerVersion(t *testing.T) {
+	data, err := proto.Marshal(&v1.Manifest{Version: ManifestVersion + 1})
+	require.NoError(t, err)
+
+	_, err = DecodeManifest(bytes.NewReader(data), ContentTypeProtobuf)
+	require.ErrorContains(t, err, "unsupported manifest version")
+}
@@ -2,24 +2,43 @@ package library
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/ra341/glacier/internal/downloader/types"
+	metadata "github.com/ra341/glacier/internal/metadata/types"
 	"github.com/ra341/glacier/internal/user"
+	"github.com/ra341/glacier/pkg/fileutil"
+	"github.com/ra341/glacier/pkg/pubsub"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 )
 
 type Downloader interface {
 	Add(ctx context.Context, gameId *Game) (err error)
+	Remove(ctx context.Context, game *Game) error
 	TriggerTracker()
+	Watch() *pubsub.Subscription[DownloadEvent]
+}
+
+// DownloadEvent is sent every time the download state of a game is saved
+type DownloadEvent struct {
+	GameId   uint
+	Download types.Download
 }
 
 type Service struct {
 	config     ConfigLoader
 	downloader Downloader
 
-	store    Store
-	manifest *ManifestService
+	store      Store
+	manifest   *ManifestService
+	playtime   StorePlaytime
+	collection StoreCollection
+	requests   StoreRequest
 }
 
 type ConfigLoader func() *Config
@@ -27,6 +46,9 @@ type ConfigLoader func() *Config
 func New(
 	store Store,
 	fs *ManifestService,
+	playtime StorePlaytime,
+	collection StoreCollection,
+	requests StoreRequest,
 	downloader Downloader,
 	config ConfigLoader,
 ) *Service {
@@ -36,6 +58,9 @@ func New(
 		config:     config,
 		store:      store,
 		manifest:   fs,
+		playtime:   playtime,
+		collection: collection,
+		requests:   requests,
 	}
 }
 
@@ -43,8 +68,26 @@ func (s *Service) Get(ctx context.Context, id uint) (Game, error) {
 	return s.store.GetById(ctx, id)
 }
 
+// Exists returns the id of the game with the metadata id,
+// 0 if it is not in the library or the user in the context cannot see it
+func (s *Service) Exists(ctx context.Context, provType metadata.ProviderType, gameDBID string) (uint, error) {
+	gameId, err := s.store.Exists(provType, gameDBID)
+	if err != nil || gameId == 0 {
+		return 0, err
+	}
+
+	_, err = s.store.GetById(ctx, gameId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return gameId, nil
+}
+
 func (s *Service) Edit(ctx context.Context, game *Game) error {
-	err := checkPerms(ctx)
+	_, err := user.Authorize(ctx, user.PermLibraryManage)
 	if err != nil {
 		return err
 	}
@@ -52,8 +95,8 @@ func (s *Service) Edit(ctx context.Context, game *Game) error {
 	return s.store.Edit(ctx, game)
 }
 
-func (s *Service) List(ctx context.Context, query string, offset, limit uint) ([]Game, error) {
-	return s.store.List(ctx, query, limit, offset)
+func (s *Service) List(ctx context.Context, query string, filter ListFilter, offset, limit uint) ([]Game, error) {
+	return s.store.List(ctx, query, filter, limit, offset)
 }
 
 func (s *Service) ListDownloading(ctx context.Context, state string) ([]Game, error) {
@@ -65,7 +108,23 @@ func (s *Service) ListDownloading(ctx context.Context, state string) ([]Game, er
 	return s.store.ListDownloadState(ctx, dState)
 }
 
+// WatchDownloads streams download state changes until the subscription is closed
+func (s *Service) WatchDownloads() *pubsub.Subscription[DownloadEvent] {
+	return s.downloader.Watch()
+}
+
 func (s *Service) Add(ctx context.Context, game *Game) error {
+	userInf, err := user.Authorize(ctx, user.PermLibraryAdd)
+	if err != nil {
+		return err
+	}
+	game.RequestedBy = userInf.ID
+
+	return s.add(ctx, game)
+}
+
+// add saves the game and starts its download, the caller checks permissions
+func (s *Service) add(ctx context.Context, game *Game) error {
 	game.Download.State = types.Queued
 	game.Download.DownloadPath = filepath.Join(
 		s.config().GameDir,
@@ -87,22 +146,154 @@ func (s *Service) Add(ctx context.Context, game *Game) error {
 }
 
 func (s *Service) Delete(ctx context.Context, id uint) error {
-	err := checkPerms(ctx)
+	_, err := user.Authorize(ctx, user.PermLibraryDelete)
 	if err != nil {
 		return err
 	}
 
+	game, err := s.store.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.downloader.Remove(ctx, &game)
+	if err != nil {
+		return err
+	}
+
+	err = fileutil.RemoveAllWithin(s.config().GameDir, game.Download.DownloadPath)
+	if err != nil {
+		return fmt.Errorf("could not remove game files: %w", err)
+	}
+
+	err = s.manifest.folderMetaStore.Delete(ctx, int(id))
+	if err != nil {
+		log.Warn().Err(err).Uint("game", id).Msg("could not delete game manifest")
+	}
+
 	return s.store.Delete(ctx, id)
 }
 
-func checkPerms(ctx context.Context) error {
+// playtimeClockSkew is how far the clock of frost may be off from the server when reporting playtime
+const playtimeClockSkew = time.Minute
+
+// ReportPlaytime adds a play session of the user in the context to their total
+func (s *Service) ReportPlaytime(ctx context.Context, gameId uint, seconds int64, playedAt time.Time) error {
 	userInf, err := user.GetUserCtx(ctx)
 	if err != nil {
 		return err
 	}
+	if seconds < 0 {
+		return fmt.Errorf("playtime cannot be negative")
+	}
+	if playedAt.After(time.Now().Add(playtimeClockSkew)) {
+		return fmt.Errorf("played at time cannot be in the future")
+	}
 
-	if userInf.Role > user.Magos {
-		return fmt.Errorf("insufficient permissions to delete")
+	game, err := s.Get(ctx, gameId)
+	if err != nil {
+		return fmt.Errorf("could not find game %d: %w", gameId, err)
 	}
-	return nil
+	// playedAt is the end of the session, it started after the game was added
+	if seconds > int64(playedAt.Add(playtimeClockSkew).Sub(game.CreatedAt)/time.Second) {
+		return fmt.Errorf("playtime is longer than the game has been in the library")
+	}
+
+	return s.playtime.AddPlaytime(ctx, userInf.ID, gameId, seconds, playedAt)
+}
+
+// ListPlaytime lists the playtime of the user in the context
+func (s *Service) ListPlaytime(ctx context.Context) ([]Playtime, error) {
+	userInf, err := user.GetUserCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.playtime.ListPlaytime(ctx, userInf.ID)
+}
+
+// ListCollection lists the owned and hidden flags of the user in the context
+func (s *Service) ListCollection(ctx context.Context) ([]UserGame, error) {
+	userInf, err := user.GetUserCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.collection.ListCollection(ctx, userInf.ID)
+}
+
+func (s *Service) SetCollection(ctx context.Context, gameId uint, owned, hidden bool) error {
+	userInf, err := user.GetUserCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.store.GetById(ctx, gameId)
+	if err != nil {
+		return fmt.Errorf("could not find game %d: %w", gameId, err)
+	}
+
+	return s.collection.SetCollection(ctx, &UserGame{
+		UserId: userInf.ID,
+		GameId: gameId,
+		Owned:  owned,
+		Hidden: hidden,
+	})
+}
+
+// GetAccess returns who can see the game, allowed for admins and the user who requested it
+func (s *Service) GetAccess(ctx context.Context, gameId uint) (Visibility, []GameAccess, error) {
+	game, err := s.store.GetById(ctx, gameId)
+	if err != nil {
+		return Public, nil, err
+	}
+	err = s.authorizeAccess(ctx, &game)
+	if err != nil {
+		return Public, nil, err
+	}
+
+	access, err := s.store.GetAccess(ctx, gameId)
+	return game.Visibility, access, err
+}
+
+// SetAccess changes who can see the game, allowed for admins and the user who requested it
+func (s *Service) SetAccess(ctx context.Context, gameId uint, visibility Visibility, access []GameAccess) error {
+	game, err := s.store.GetById(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	err = s.authorizeAccess(ctx, &game)
+	if err != nil {
+		return err
+	}
+
+	for i, a := range access {
+		if (a.UserId == 0) == (a.Role == "") {
+			return fmt.Errorf("access must be given to either a user or a role")
+		}
+		if a.Role != "" {
+			// grants are matched against the canonical role name
+			r, err := user.RoleString(a.Role)
+			if err != nil {
+				return err
+			}
+			access[i].Role = r.String()
+		}
+	}
+
+	return s.store.SetAccess(ctx, gameId, visibility, access)
+}
+
+// authorizeAccess allows admins and the user who requested the game to manage its access
+func (s *Service) authorizeAccess(ctx context.Context, game *Game) error {
+	userInf, err := user.GetUserCtx(ctx)
+	if err != nil {
+		return err
+	}
+	if game.RequestedBy == userInf.ID {
+		return nil
+	}
+
+	_, err = user.Authorize(ctx, user.PermLibraryManage)
+	return err
 }
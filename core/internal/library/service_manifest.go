@@ -2,12 +2,12 @@ package library
 
 import (
 	"context"
-	"encoding/gob"
 	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 
 	"github.com/cespare/xxhash/v2"
@@ -66,14 +66,14 @@ func (s *ManifestService) FileDownload(ctx context.Context, id int, file string)
 	return os.Open(filePath)
 }
 
-func (s *ManifestService) GetDownloadManifest(ctx context.Context, gameId int, writer io.Writer) error {
+// GetDownloadManifest writes the manifest encoded as contentType, see NegotiateManifest
+func (s *ManifestService) GetDownloadManifest(ctx context.Context, gameId int, writer io.Writer, contentType string) error {
 	meta, err := s.GenerateManifest(ctx, gameId)
 	if err != nil {
 		return err
 	}
 
-	encoder := gob.NewEncoder(writer)
-	return encoder.Encode(meta)
+	return EncodeManifest(writer, contentType, &meta)
 }
 
 func (s *ManifestService) GenerateManifest(ctx context.Context, gameId int) (FolderManifest, error) {
@@ -144,6 +144,11 @@ func (s *ManifestService) GenerateManifest(ctx context.Context, gameId int) (Fol
 		return FolderManifest{}, err
 	}
 
+	// files removed since the previous manifest leave empty slots
+	finalMeta.FileInfo = slices.DeleteFunc(finalMeta.FileInfo, func(fm FileManifest) bool {
+		return fm.RelPath == ""
+	})
+
 	err = s.folderMetaStore.Add(ctx, gameId, &finalMeta)
 	if err != nil {
 		return FolderManifest{}, err
@@ -190,7 +195,9 @@ func (s *ManifestService) gatherMeta(
 			res.InsertIndex = uint(i)
 			res.Update = true
 
-			if prevState.ModTime.Equal(curStat.ModTime()) {
+			// manifests from older versions have no block hashes
+			hasBlocks := prevState.BlockSize == BlockSize && len(prevState.Blocks) > 0
+			if prevState.ModTime.Equal(curStat.ModTime()) && hasBlocks {
 				// file is not modified, meta does not need to be updated
 				log.Info().Str("file", relPath).Msg("using cached metadata")
 				res.meta = prevState
@@ -213,16 +220,18 @@ func (s *ManifestService) gatherMeta(
 		Str("file", relPath).
 		Msg("metadata cache miss")
 
-	hash, err := GetHash(path)
+	hash, blocks, err := GetBlockHashes(path, BlockSize)
 	if err != nil {
 		return err
 	}
 
 	res.meta = FileManifest{
-		RelPath:  relPath,
-		Size:     curStat.Size(),
-		ModTime:  curStat.ModTime(),
-		Checksum: hash,
+		RelPath:   relPath,
+		Size:      curStat.Size(),
+		ModTime:   curStat.ModTime(),
+		Checksum:  hash,
+		BlockSize: BlockSize,
+		Blocks:    blocks,
 	}
 
 	log.Debug().Str("file", relPath).Msg("metadata done")
@@ -250,3 +259,38 @@ func GetHash(path string) (string, error) {
 
 	return strconv.FormatUint(sum64, 10), nil
 }
+
+// GetBlockHashes returns the hash of the whole file and of every blockSize block in a single read
+func GetBlockHashes(path string, blockSize int64) (string, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "0", nil, err
+	}
+	defer fileutil.Close(f)
+
+	fileHash := xxhash.New()
+	blockHash := xxhash.New()
+	blocks := []string{}
+
+	// 1MB buffer to keep the pipeline full
+	buf := make([]byte, 1024*1024)
+	for {
+		// both hashes see the same bytes, blockHash is reset at every boundary
+		n, err := io.CopyBuffer(io.MultiWriter(fileHash, blockHash), io.LimitReader(f, blockSize), buf)
+		if err != nil {
+			return "0", nil, err
+		}
+		if n == 0 {
+			break
+		}
+
+		blocks = append(blocks, strconv.FormatUint(blockHash.Sum64(), 10))
+		blockHash.Reset()
+
+		if n < blockSize {
+			break
+		}
+	}
+
+	return strconv.FormatUint(fileHash.Sum64(), 10), blocks, nil
+}
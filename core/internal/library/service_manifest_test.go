@@ -0,0 +1,39 @@
+package library
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlockHashes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.pak")
+	contents := []byte("aaaabbbbcc")
+	require.NoError(t, os.WriteFile(path, contents, 0644))
+
+	hash, blocks, err := GetBlockHashes(path, 4)
+	require.NoError(t, err)
+
+	sum := func(b []byte) string { return strconv.FormatUint(xxhash.Sum64(b), 10) }
+	require.Equal(t, sum(contents), hash)
+	require.Equal(t, []string{sum([]byte("aaaa")), sum([]byte("bbbb")), sum([]byte("cc"))}, blocks)
+
+	fullHash, err := GetHash(path)
+	require.NoError(t, err)
+	require.Equal(t, fullHash, hash)
+
+	// exact multiple of the block size has no empty trailing block
+	_, blocks, err = GetBlockHashes(path, 5)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+
+	empty := filepath.Join(t.TempDir(), "empty")
+	require.NoError(t, os.WriteFile(empty, nil, 0644))
+	_, blocks, err = GetBlockHashes(empty, 4)
+	require.NoError(t, err)
+	require.Empty(t, blocks)
+}
@@ -0,0 +1,189 @@
+package library
+
+import (
+	"context"
+	"fmt"
+
+	indexer "github.com/ra341/glacier/internal/indexer/types"
+	metadata "github.com/ra341/glacier/internal/metadata/types"
+	"github.com/ra341/glacier/internal/user"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Request files a request for the metadata match by the user in the context
+func (s *Service) Request(ctx context.Context, meta *metadata.Meta, note string) (*GameRequest, error) {
+	userInf, err := user.Authorize(ctx, user.PermLibraryRequest)
+	if err != nil {
+		return nil, err
+	}
+	if meta.GameDBID == "" {
+		return nil, fmt.Errorf("a metadata match is needed to request a game")
+	}
+
+	gameId, err := s.store.Exists(meta.ProviderType, meta.GameDBID)
+	if err != nil {
+		return nil, err
+	}
+	if gameId != 0 {
+		return nil, fmt.Errorf("%s is already in the library", meta.Name)
+	}
+
+	pending, err := s.requests.ListPendingRequests(ctx, meta.ProviderType, meta.GameDBID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pending {
+		if p.RequestedBy == userInf.ID {
+			return nil, fmt.Errorf("%s is already requested", meta.Name)
+		}
+	}
+
+	req := &GameRequest{
+		ProviderType: meta.ProviderType,
+		GameDBID:     meta.GameDBID,
+		Meta:         *meta,
+		RequestedBy:  userInf.ID,
+		Note:         note,
+		Status:       RequestPending,
+	}
+	err = s.requests.AddRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ListRequests lists the requests of the user in the context,
+// all lists every request and needs requests:manage
+func (s *Service) ListRequests(ctx context.Context, all bool, status *RequestStatus) ([]GameRequest, error) {
+	userInf, err := user.GetUserCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userId := userInf.ID
+	if all {
+		_, err = user.Authorize(ctx, user.PermRequestsManage)
+		if err != nil {
+			return nil, err
+		}
+		userId = 0
+	}
+
+	return s.requests.ListRequests(ctx, userId, status)
+}
+
+// ApproveRequest adds the game of the request with the indexer source and starts its download,
+// the game is added as requested by the user who filed the request
+func (s *Service) ApproveRequest(ctx context.Context, id uint, source *indexer.Source) (*GameRequest, error) {
+	reviewer, err := user.Authorize(ctx, user.PermRequestsManage)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.pendingRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if source.DownloadUrl == "" {
+		return nil, fmt.Errorf("an indexer source is needed to approve a request")
+	}
+
+	// the game may have been added since the request was filed
+	gameId, err := s.store.Exists(req.ProviderType, req.GameDBID)
+	if err != nil {
+		return nil, err
+	}
+	if gameId != 0 {
+		return nil, fmt.Errorf("%s is already in the library", req.Meta.Name)
+	}
+
+	game := &Game{
+		Meta:        req.Meta,
+		Source:      *source,
+		RequestedBy: req.RequestedBy,
+	}
+	err = s.add(ctx, game)
+	if err != nil {
+		return nil, fmt.Errorf("could not add game: %w", err)
+	}
+
+	// the game is in the library now, failing here would make the caller add it again
+	req.Status = RequestApproved
+	req.ReviewedBy = reviewer.ID
+	req.GameId = game.ID
+
+	// other users who asked for the same game get it as well
+	pending, err := s.requests.ListPendingRequests(ctx, req.ProviderType, req.GameDBID)
+	if err != nil {
+		log.Warn().Err(err).Uint("game", game.ID).Msg("game was added but its requests could not be listed")
+		pending = []GameRequest{req}
+	}
+	for _, p := range pending {
+		p.Status = RequestApproved
+		p.ReviewedBy = reviewer.ID
+		p.GameId = game.ID
+		err = s.requests.ReviewRequest(ctx, &p)
+		if err != nil {
+			log.Warn().Err(err).Uint("request", p.ID).Uint("game", game.ID).Msg("game was added but the request could not be saved")
+		}
+	}
+
+	return &req, nil
+}
+
+func (s *Service) DenyRequest(ctx context.Context, id uint, reason string) (*GameRequest, error) {
+	reviewer, err := user.Authorize(ctx, user.PermRequestsManage)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.pendingRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Status = RequestDenied
+	req.ReviewedBy = reviewer.ID
+	req.Reason = reason
+	err = s.requests.ReviewRequest(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// CancelRequest deletes a pending request, allowed for the requester and users with requests:manage
+func (s *Service) CancelRequest(ctx context.Context, id uint) error {
+	userInf, err := user.GetUserCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := s.pendingRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	if req.RequestedBy != userInf.ID {
+		_, err = user.Authorize(ctx, user.PermRequestsManage)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.requests.DeleteRequest(ctx, id)
+}
+
+func (s *Service) pendingRequest(ctx context.Context, id uint) (GameRequest, error) {
+	req, err := s.requests.GetRequest(ctx, id)
+	if err != nil {
+		return GameRequest{}, fmt.Errorf("could not find request %d: %w", id, err)
+	}
+	if req.Status != RequestPending {
+		return GameRequest{}, fmt.Errorf("request %d is already %s", id, req.Status)
+	}
+	return req, nil
+}
@@ -0,0 +1,173 @@
+package library
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ra341/glacier/internal/database"
+	indexer "github.com/ra341/glacier/internal/indexer/types"
+	metadata "github.com/ra341/glacier/internal/metadata/types"
+	"github.com/ra341/glacier/internal/user"
+	"github.com/ra341/glacier/pkg/pubsub"
+	"github.com/stretchr/testify/require"
+)
+
+type addedDownloads struct {
+	games []uint
+}
+
+func (d *addedDownloads) Add(ctx context.Context, game *Game) error {
+	d.games = append(d.games, game.ID)
+	return nil
+}
+
+func (d *addedDownloads) Remove(ctx context.Context, game *Game) error { return nil }
+func (d *addedDownloads) TriggerTracker()                              {}
+func (d *addedDownloads) Watch() *pubsub.Subscription[DownloadEvent] {
+	return new(pubsub.Broker[DownloadEvent]).Subscribe(0)
+}
+
+func TestRequestApproval(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	downloads := &addedDownloads{}
+	gameDir := t.TempDir()
+	srv := New(NewStoreGorm(db), nil, nil, nil, NewStoreRequestGorm(db), downloads, func() *Config {
+		return &Config{GameDir: gameDir}
+	})
+
+	priest := userCtx(2, user.TechPriest)
+	other := userCtx(3, user.TechPriest)
+	admin := userCtx(5, user.Magos)
+	meta := &metadata.Meta{Name: "requested", GameDBID: "10"}
+
+	// priests can only request
+	err := srv.Add(priest, &Game{Meta: *meta})
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+
+	req, err := srv.Request(priest, meta, "please")
+	require.NoError(t, err)
+	require.Equal(t, RequestPending, req.Status)
+
+	_, err = srv.Request(priest, meta, "again")
+	require.Error(t, err)
+	otherReq, err := srv.Request(other, meta, "")
+	require.NoError(t, err)
+
+	// requesters only see their own requests
+	mine, err := srv.ListRequests(priest, false, nil)
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	_, err = srv.ListRequests(priest, true, nil)
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+	all, err := srv.ListRequests(admin, true, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	source := &indexer.Source{Title: "requested", DownloadUrl: "magnet:?xt=1"}
+	_, err = srv.ApproveRequest(priest, req.ID, source)
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+
+	approved, err := srv.ApproveRequest(admin, req.ID, source)
+	require.NoError(t, err)
+	require.Equal(t, RequestApproved, approved.Status)
+	require.Equal(t, uint(5), approved.ReviewedBy)
+	require.Equal(t, []uint{approved.GameId}, downloads.games)
+
+	game, err := srv.Get(priest, approved.GameId)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), game.RequestedBy)
+	require.Equal(t, "requested", game.Source.Title)
+
+	// only the requester and admins see who has access
+	_, _, err = srv.GetAccess(priest, game.ID)
+	require.NoError(t, err)
+	_, _, err = srv.GetAccess(other, game.ID)
+	require.ErrorIs(t, err, user.ErrPermissionDenied)
+
+	// the other request for the same game is approved with it
+	pending := RequestPending
+	left, err := srv.ListRequests(admin, true, &pending)
+	require.NoError(t, err)
+	require.Empty(t, left)
+	_, err = srv.DenyRequest(admin, otherReq.ID, "")
+	require.Error(t, err)
+
+	// the game is in the library now
+	_, err = srv.Request(other, meta, "")
+	require.Error(t, err)
+
+	denied, err := srv.Request(priest, &metadata.Meta{Name: "denied", GameDBID: "11"}, "")
+	require.NoError(t, err)
+	denied, err = srv.DenyRequest(admin, denied.ID, "not available")
+	require.NoError(t, err)
+	require.Equal(t, RequestDenied, denied.Status)
+	require.Equal(t, "not available", denied.Reason)
+
+	// games added after the request was filed are not added twice
+	added := &metadata.Meta{Name: "added", GameDBID: "13"}
+	addedReq, err := srv.Request(priest, added, "")
+	require.NoError(t, err)
+	require.NoError(t, srv.Add(admin, &Game{Meta: *added}))
+	_, err = srv.ApproveRequest(admin, addedReq.ID, source)
+	require.ErrorContains(t, err, "already in the library")
+	require.NoError(t, srv.CancelRequest(priest, addedReq.ID))
+
+	cancelled, err := srv.Request(priest, &metadata.Meta{Name: "cancelled", GameDBID: "12"}, "")
+	require.NoError(t, err)
+	require.Error(t, srv.CancelRequest(other, cancelled.ID))
+	require.NoError(t, srv.CancelRequest(priest, cancelled.ID))
+
+	mine, err = srv.ListRequests(priest, false, nil)
+	require.NoError(t, err)
+	require.Len(t, mine, 2)
+}
+
+func TestSetAccessRole(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	gameDir := t.TempDir()
+	srv := New(NewStoreGorm(db), nil, nil, nil, NewStoreRequestGorm(db), &addedDownloads{}, func() *Config {
+		return &Config{GameDir: gameDir}
+	})
+
+	priest := userCtx(2, user.TechPriest)
+	admin := userCtx(5, user.Magos)
+
+	game := &Game{Meta: metadata.Meta{Name: "shared", GameDBID: "20"}}
+	require.NoError(t, srv.Add(admin, game))
+
+	// role grants are stored in their canonical case
+	require.NoError(t, srv.SetAccess(admin, game.ID, Private, []GameAccess{{Role: "techpriest"}}))
+	_, access, err := srv.GetAccess(admin, game.ID)
+	require.NoError(t, err)
+	require.Len(t, access, 1)
+	require.Equal(t, user.TechPriest.String(), access[0].Role)
+
+	_, err = srv.Get(priest, game.ID)
+	require.NoError(t, err)
+
+	require.Error(t, srv.SetAccess(admin, game.ID, Private, []GameAccess{{Role: "servitor"}}))
+}
+
+func TestExistsVisibility(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	gameDir := t.TempDir()
+	srv := New(NewStoreGorm(db), nil, nil, nil, NewStoreRequestGorm(db), &addedDownloads{}, func() *Config {
+		return &Config{GameDir: gameDir}
+	})
+
+	priest := userCtx(2, user.TechPriest)
+	admin := userCtx(5, user.Magos)
+
+	game := &Game{Meta: metadata.Meta{Name: "hidden", GameDBID: "30"}}
+	require.NoError(t, srv.Add(admin, game))
+	require.NoError(t, srv.SetAccess(admin, game.ID, Private, nil))
+
+	// private games are not revealed to users who cannot see them
+	gameId, err := srv.Exists(priest, game.Meta.ProviderType, game.Meta.GameDBID)
+	require.NoError(t, err)
+	require.Zero(t, gameId)
+
+	gameId, err = srv.Exists(admin, game.Meta.ProviderType, game.Meta.GameDBID)
+	require.NoError(t, err)
+	require.Equal(t, game.ID, gameId)
+}
@@ -3,9 +3,12 @@ package library
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/ra341/glacier/internal/database"
 	"github.com/ra341/glacier/internal/downloader/types"
 	metaTypes "github.com/ra341/glacier/internal/metadata/types"
+	"github.com/ra341/glacier/internal/user"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,14 +23,46 @@ var testGame = Game{
 }
 
 func TestMeta(t *testing.T) {
-	srv := New(nil, nil, nil, nil)
+	srv := New(nil, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
-	err := srv.manifest.GetDownloadManifest(ctx, 1, nil)
+	err := srv.manifest.GetDownloadManifest(ctx, 1, nil, ContentTypeProtobuf)
 	require.NoError(t, err)
 
 }
 
+func TestReportPlaytime(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	gameDir := t.TempDir()
+	srv := New(NewStoreGorm(db), nil, NewStorePlaytimeGorm(db), nil, nil, &addedDownloads{}, func() *Config {
+		return &Config{GameDir: gameDir}
+	})
+
+	priest := userCtx(2, user.TechPriest)
+	admin := userCtx(5, user.Magos)
+
+	game := &Game{Meta: metaTypes.Meta{Name: "played", GameDBID: "40"}}
+	require.NoError(t, srv.Add(admin, game))
+	game.CreatedAt = time.Now().Add(-2 * time.Hour)
+	require.NoError(t, db.Model(game).Update("created_at", game.CreatedAt).Error)
+
+	require.NoError(t, srv.ReportPlaytime(priest, game.ID, 3600, time.Now()))
+
+	// sessions cannot be longer than the game has been in the library
+	require.Error(t, srv.ReportPlaytime(priest, game.ID, 3*3600, time.Now()))
+	require.Error(t, srv.ReportPlaytime(priest, game.ID, 60, time.Now().Add(time.Hour)))
+	require.Error(t, srv.ReportPlaytime(priest, game.ID, -1, time.Now()))
+
+	// private games can only be played by the users who can see them
+	require.NoError(t, srv.SetAccess(admin, game.ID, Private, nil))
+	require.Error(t, srv.ReportPlaytime(priest, game.ID, 60, time.Now()))
+
+	playtime, err := srv.ListPlaytime(priest)
+	require.NoError(t, err)
+	require.Len(t, playtime, 1)
+	require.Equal(t, int64(3600), playtime[0].Seconds)
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type TestStore struct {
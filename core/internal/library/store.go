@@ -18,6 +18,39 @@ type Game struct {
 	Download download.Download `gorm:"embedded"`
 	// Source of the Indexer
 	Source indexer.Source `gorm:"embedded"`
+
+	// user who added the game, 0 for games added before users were tracked
+	RequestedBy uint `gorm:"index"`
+	Visibility  Visibility
+}
+
+//go:generate go run github.com/dmarkham/enumer@latest -sql -type=Visibility -output=enum_library_visibility.go
+type Visibility int
+
+const (
+	// Public games are visible to every user
+	Public Visibility = iota
+	// Private games are visible to admins, the user who requested them and the users and roles in their access list
+	Private
+)
+
+// GameAccess lets a user, or every user with a role, see a private game
+type GameAccess struct {
+	gorm.Model
+
+	GameID int  `gorm:"index"`
+	Game   Game `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	// 0 for a role grant
+	UserId uint
+	// role name, empty for a user grant
+	Role string
+}
+
+// ListFilter narrows List down to the collection of the user in the context
+type ListFilter struct {
+	OwnedOnly     bool
+	IncludeHidden bool
 }
 
 type GameConfig struct {
@@ -35,9 +68,15 @@ type Store interface {
 	Delete(ctx context.Context, id uint) error
 	Exists(provType metadata.ProviderType, GameDBID string) (uint, error)
 
-	List(ctx context.Context, query string, limit uint, offset uint) ([]Game, error)
+	// List, ListDownloadState and GetById only return games the user in the context can see,
+	// calls without a user see every game
+	List(ctx context.Context, query string, filter ListFilter, limit uint, offset uint) ([]Game, error)
 	ListDownloadState(ctx context.Context, state download.DownloadState) ([]Game, error)
 	GetById(ctx context.Context, id uint) (Game, error)
 
+	GetAccess(ctx context.Context, id uint) ([]GameAccess, error)
+	// SetAccess replaces the access list of the game
+	SetAccess(ctx context.Context, id uint, visibility Visibility, access []GameAccess) error
+
 	UpdateDownloadProgress(ctx context.Context, id uint, download download.Download) error
 }
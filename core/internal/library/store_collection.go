@@ -0,0 +1,24 @@
+package library
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type StoreCollection interface {
+	// SetCollection saves the flags of the game for the user
+	SetCollection(ctx context.Context, entry *UserGame) error
+	ListCollection(ctx context.Context, userId uint) ([]UserGame, error)
+}
+
+// UserGame is a game in the collection of a user
+type UserGame struct {
+	gorm.Model
+
+	UserId uint `gorm:"uniqueIndex:idx_user_game"`
+	GameId uint `gorm:"uniqueIndex:idx_user_game"`
+	Owned  bool
+	// hidden games are left out of the library list of the user
+	Hidden bool
+}
@@ -0,0 +1,40 @@
+package library
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StoreCollectionGorm struct {
+	db *gorm.DB
+}
+
+func NewStoreCollectionGorm(db *gorm.DB) StoreCollection {
+	return &StoreCollectionGorm{db: db}
+}
+
+func (s *StoreCollectionGorm) SetCollection(ctx context.Context, entry *UserGame) error {
+	return s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "game_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"owned":      entry.Owned,
+				"hidden":     entry.Hidden,
+				"updated_at": time.Now(),
+			}),
+		}).
+		Create(entry).
+		Error
+}
+
+func (s *StoreCollectionGorm) ListCollection(ctx context.Context, userId uint) ([]UserGame, error) {
+	var collection []UserGame
+	err := s.db.WithContext(ctx).
+		Where("user_id = ?", userId).
+		Find(&collection).
+		Error
+	return collection, err
+}
@@ -30,4 +30,13 @@ type FileManifest struct {
 	Size     int64
 	ModTime  time.Time
 	Checksum string
+
+	// BlockSize is the size of each hashed block, the last one can be shorter
+	BlockSize int64
+	// Blocks holds the hash of every block in order,
+	// clients compare them to only download the changed ranges of a file
+	Blocks []string
 }
+
+// BlockSize of new manifests, matches the chunk size used by frost
+const BlockSize int64 = 128 * 1024 * 1024
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ra341/glacier/internal/downloader/types"
 	metadata "github.com/ra341/glacier/internal/metadata/types"
+	"github.com/ra341/glacier/internal/user"
 	"gorm.io/gorm"
 )
 
@@ -43,7 +44,11 @@ func (s *StoreGorm) Exists(provType metadata.ProviderType, GameDBID string) (uin
 func (s *StoreGorm) ListDownloadState(ctx context.Context, state types.DownloadState) ([]Game, error) {
 	var downloads []Game
 
-	err := s.gormDB.Where("state = ?", state).Find(&downloads).Error
+	err := s.Q(ctx).
+		Scopes(visible(ctx)).
+		Where("state = ?", state).
+		Find(&downloads).
+		Error
 
 	return downloads, err
 }
@@ -52,13 +57,13 @@ func (s *StoreGorm) Add(ctx context.Context, game *Game) error {
 	return s.Q(ctx).Save(game).Error
 }
 
-func (s *StoreGorm) List(ctx context.Context, query string, limit uint, offset uint) ([]Game, error) {
+func (s *StoreGorm) List(ctx context.Context, query string, filter ListFilter, limit uint, offset uint) ([]Game, error) {
 	var games []Game
 
-	whereQ := s.Q(ctx)
+	whereQ := s.Q(ctx).Scopes(visible(ctx), inCollection(ctx, filter))
 	if query != "" {
 		searchTerm := "%" + query + "%"
-		whereQ = whereQ.Where("title LIKE ?", searchTerm).Or("description LIKE ?", searchTerm)
+		whereQ = whereQ.Where("name LIKE ? OR short_desc LIKE ?", searchTerm, searchTerm)
 	}
 
 	err := whereQ.
@@ -82,6 +87,8 @@ func (s *StoreGorm) UpdateDownloadProgress(ctx context.Context, id uint, downloa
 			`incomplete_path`,
 			`left`,
 			`complete`,
+			`extracted`,
+			`extract_total`,
 		).
 		UpdateColumns(Game{
 			Download: download,
@@ -90,10 +97,87 @@ func (s *StoreGorm) UpdateDownloadProgress(ctx context.Context, id uint, downloa
 
 func (s *StoreGorm) GetById(ctx context.Context, id uint) (Game, error) {
 	var game Game
-	err := s.Q(ctx).First(&game, id).Error
+	err := s.Q(ctx).Scopes(visible(ctx)).First(&game, id).Error
 	return game, err
 }
 
+func (s *StoreGorm) GetAccess(ctx context.Context, id uint) ([]GameAccess, error) {
+	var access []GameAccess
+	err := s.Q(ctx).Where("game_id = ?", id).Find(&access).Error
+	return access, err
+}
+
+func (s *StoreGorm) SetAccess(ctx context.Context, id uint, visibility Visibility, access []GameAccess) error {
+	return s.Q(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&Game{Model: gorm.Model{ID: id}}).
+			Update("visibility", visibility).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("game_id = ?", id).Unscoped().Delete(&GameAccess{}).Error
+		if err != nil {
+			return err
+		}
+		if len(access) == 0 {
+			return nil
+		}
+
+		for i := range access {
+			access[i].GameID = int(id)
+		}
+		return tx.Create(&access).Error
+	})
+}
+
+// visible limits the query to the games the user in the context can see,
+// users who manage the library and calls without a user see every game
+func visible(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		u, err := user.GetUserCtx(ctx)
+		if err != nil || u.Can(user.PermLibraryManage) {
+			return db
+		}
+
+		granted := db.Session(&gorm.Session{NewDB: true}).
+			Model(&GameAccess{}).
+			Select("game_id").
+			Where("user_id = ? OR role = ?", u.ID, u.Role.String())
+
+		// visibility is NULL for games added before it existed
+		return db.Where(
+			"games.visibility IS NOT ? OR games.requested_by = ? OR games.id IN (?)",
+			Private, u.ID, granted,
+		)
+	}
+}
+
+// inCollection applies the owned and hidden flags of the user in the context
+func inCollection(ctx context.Context, filter ListFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		u, err := user.GetUserCtx(ctx)
+		if err != nil {
+			return db
+		}
+
+		collection := func(flag string) *gorm.DB {
+			return db.Session(&gorm.Session{NewDB: true}).
+				Model(&UserGame{}).
+				Select("game_id").
+				Where("user_id = ? AND "+flag+" = ?", u.ID, true)
+		}
+
+		if filter.OwnedOnly {
+			db = db.Where("games.id IN (?)", collection("owned"))
+		}
+		if !filter.IncludeHidden {
+			db = db.Where("games.id NOT IN (?)", collection("hidden"))
+		}
+		return db
+	}
+}
+
 func (s *StoreGorm) Delete(ctx context.Context, id uint) error {
 	return s.Q(ctx).Unscoped().Delete(&Game{}, id).Error
 }
@@ -0,0 +1,92 @@
+package library
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ra341/glacier/internal/database"
+	metadata "github.com/ra341/glacier/internal/metadata/types"
+	"github.com/ra341/glacier/internal/user"
+	"github.com/stretchr/testify/require"
+)
+
+func userCtx(id uint, role user.Role) context.Context {
+	u := &user.User{Role: role, Permissions: user.DefaultPermissions(role)}
+	u.ID = id
+	return context.WithValue(context.Background(), user.CtxKeyUser, u)
+}
+
+func gameTitles(games []Game) []string {
+	var titles []string
+	for _, g := range games {
+		titles = append(titles, g.Meta.Name)
+	}
+	return titles
+}
+
+func TestVisibility(t *testing.T) {
+	db := database.New(t.TempDir(), false)
+	store := NewStoreGorm(db)
+	collection := NewStoreCollectionGorm(db)
+
+	owner := userCtx(2, user.TechPriest)
+	friend := userCtx(3, user.TechPriest)
+	stranger := userCtx(4, user.TechPriest)
+	admin := userCtx(5, user.Magos)
+
+	public := &Game{Meta: metadata.Meta{Name: "public", GameDBID: "1"}}
+	private := &Game{Meta: metadata.Meta{Name: "private", GameDBID: "2"}, RequestedBy: 2, Visibility: Private}
+	byRole := &Game{Meta: metadata.Meta{Name: "by role", GameDBID: "3"}, RequestedBy: 5, Visibility: Private}
+	for _, g := range []*Game{public, private, byRole} {
+		require.NoError(t, store.Add(context.Background(), g))
+	}
+	require.NoError(t, store.SetAccess(context.Background(), private.ID, Private, []GameAccess{{UserId: 3}}))
+	require.NoError(t, store.SetAccess(context.Background(), byRole.ID, Private, []GameAccess{{Role: user.TechPriest.String()}}))
+
+	list := func(ctx context.Context, filter ListFilter) []string {
+		games, err := store.List(ctx, "", filter, 10, 0)
+		require.NoError(t, err)
+		return gameTitles(games)
+	}
+
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(owner, ListFilter{}))
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(friend, ListFilter{}))
+	require.ElementsMatch(t, []string{"public", "by role"}, list(stranger, ListFilter{}))
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(admin, ListFilter{}))
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(context.Background(), ListFilter{}))
+
+	_, err := store.GetById(stranger, private.ID)
+	require.Error(t, err)
+	_, err = store.GetById(friend, private.ID)
+	require.NoError(t, err)
+
+	// a search keeps the visibility filter
+	games, err := store.List(stranger, "priv", ListFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, games)
+	games, err = store.List(friend, "priv", ListFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"private"}, gameTitles(games))
+
+	require.NoError(t, collection.SetCollection(owner, &UserGame{UserId: 2, GameId: public.ID, Hidden: true}))
+	require.NoError(t, collection.SetCollection(owner, &UserGame{UserId: 2, GameId: private.ID, Owned: true}))
+	require.ElementsMatch(t, []string{"private", "by role"}, list(owner, ListFilter{}))
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(owner, ListFilter{IncludeHidden: true}))
+	require.ElementsMatch(t, []string{"private"}, list(owner, ListFilter{OwnedOnly: true}))
+	// flags are per user
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(friend, ListFilter{}))
+
+	// updating the flags replaces them
+	require.NoError(t, collection.SetCollection(owner, &UserGame{UserId: 2, GameId: public.ID}))
+	entries, err := collection.ListCollection(owner, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(owner, ListFilter{}))
+
+	// making the game public again clears its access list
+	require.NoError(t, store.SetAccess(context.Background(), private.ID, Public, nil))
+	access, err := store.GetAccess(context.Background(), private.ID)
+	require.NoError(t, err)
+	require.Empty(t, access)
+	require.ElementsMatch(t, []string{"public", "private", "by role"}, list(stranger, ListFilter{}))
+}
@@ -0,0 +1,25 @@
+package library
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type StorePlaytime interface {
+	// AddPlaytime adds a play session to the total of the user for the game
+	AddPlaytime(ctx context.Context, userId, gameId uint, seconds int64, playedAt time.Time) error
+	ListPlaytime(ctx context.Context, userId uint) ([]Playtime, error)
+}
+
+// Playtime is the total time a user played a game, reported by frost
+type Playtime struct {
+	gorm.Model
+
+	UserId     uint `gorm:"uniqueIndex:idx_playtime_user_game"`
+	GameId     uint `gorm:"uniqueIndex:idx_playtime_user_game"`
+	Seconds    int64
+	Sessions   int
+	LastPlayed time.Time
+}
@@ -0,0 +1,50 @@
+package library
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StorePlaytimeGorm struct {
+	db *gorm.DB
+}
+
+func NewStorePlaytimeGorm(db *gorm.DB) StorePlaytime {
+	return &StorePlaytimeGorm{db: db}
+}
+
+func (s *StorePlaytimeGorm) AddPlaytime(ctx context.Context, userId, gameId uint, seconds int64, playedAt time.Time) error {
+	playtime := Playtime{
+		UserId:     userId,
+		GameId:     gameId,
+		Seconds:    seconds,
+		Sessions:   1,
+		LastPlayed: playedAt,
+	}
+
+	return s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "game_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"seconds":     gorm.Expr("seconds + ?", seconds),
+				"sessions":    gorm.Expr("sessions + 1"),
+				"last_played": playedAt,
+				"updated_at":  time.Now(),
+			}),
+		}).
+		Create(&playtime).
+		Error
+}
+
+func (s *StorePlaytimeGorm) ListPlaytime(ctx context.Context, userId uint) ([]Playtime, error) {
+	var playtime []Playtime
+	err := s.db.WithContext(ctx).
+		Where("user_id = ?", userId).
+		Order("last_played desc").
+		Find(&playtime).
+		Error
+	return playtime, err
+}
@@ -0,0 +1,53 @@
+package library
+
+import (
+	"context"
+
+	metadata "github.com/ra341/glacier/internal/metadata/types"
+
+	"gorm.io/gorm"
+)
+
+type StoreRequest interface {
+	AddRequest(ctx context.Context, req *GameRequest) error
+	GetRequest(ctx context.Context, id uint) (GameRequest, error)
+	// ListRequests lists the requests of the user, every request when userId is 0,
+	// every status when status is nil
+	ListRequests(ctx context.Context, userId uint, status *RequestStatus) ([]GameRequest, error)
+	// ListPendingRequests lists the pending requests of every user for the match
+	ListPendingRequests(ctx context.Context, provType metadata.ProviderType, gameDBID string) ([]GameRequest, error)
+	// ReviewRequest saves the status, reviewer, reason and game of the request
+	ReviewRequest(ctx context.Context, req *GameRequest) error
+	DeleteRequest(ctx context.Context, id uint) error
+}
+
+//go:generate go run github.com/dmarkham/enumer@latest -sql -type=RequestStatus -trimprefix=Request -output=enum_library_request_status.go
+type RequestStatus int
+
+const (
+	RequestPending RequestStatus = iota
+	RequestApproved
+	RequestDenied
+)
+
+// GameRequest asks for a metadata match to be added to the library,
+// the game is only added once a user with requests:manage approves it
+type GameRequest struct {
+	gorm.Model
+
+	// kept apart from Meta so a game can be requested by more than one user
+	ProviderType metadata.ProviderType `gorm:"index:idx_request_match"`
+	GameDBID     string                `gorm:"index:idx_request_match"`
+	Meta         metadata.Meta         `gorm:"serializer:json"`
+
+	RequestedBy uint `gorm:"index"`
+	// optional message for the reviewer
+	Note string
+
+	Status     RequestStatus
+	ReviewedBy uint
+	// why the request was denied
+	Reason string
+	// game added when the request was approved
+	GameId uint
+}
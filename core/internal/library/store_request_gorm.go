@@ -0,0 +1,63 @@
+package library
+
+import (
+	"context"
+
+	metadata "github.com/ra341/glacier/internal/metadata/types"
+
+	"gorm.io/gorm"
+)
+
+type StoreRequestGorm struct {
+	db *gorm.DB
+}
+
+func NewStoreRequestGorm(db *gorm.DB) StoreRequest {
+	return &StoreRequestGorm{db: db}
+}
+
+func (s *StoreRequestGorm) AddRequest(ctx context.Context, req *GameRequest) error {
+	return s.db.WithContext(ctx).Create(req).Error
+}
+
+func (s *StoreRequestGorm) GetRequest(ctx context.Context, id uint) (GameRequest, error) {
+	var req GameRequest
+	err := s.db.WithContext(ctx).First(&req, id).Error
+	return req, err
+}
+
+func (s *StoreRequestGorm) ListRequests(ctx context.Context, userId uint, status *RequestStatus) ([]GameRequest, error) {
+	q := s.db.WithContext(ctx).Order("created_at desc")
+	if userId != 0 {
+		q = q.Where("requested_by = ?", userId)
+	}
+	if status != nil {
+		q = q.Where("status = ?", *status)
+	}
+
+	var requests []GameRequest
+	err := q.Find(&requests).Error
+	return requests, err
+}
+
+func (s *StoreRequestGorm) ListPendingRequests(ctx context.Context, provType metadata.ProviderType, gameDBID string) ([]GameRequest, error) {
+	var requests []GameRequest
+	err := s.db.WithContext(ctx).
+		Where("provider_type = ? AND game_db_id = ?", provType, gameDBID).
+		Where("status = ?", RequestPending).
+		Find(&requests).
+		Error
+	return requests, err
+}
+
+func (s *StoreRequestGorm) ReviewRequest(ctx context.Context, req *GameRequest) error {
+	return s.db.WithContext(ctx).
+		Model(req).
+		Select("status", "reviewed_by", "reason", "game_id").
+		Updates(req).
+		Error
+}
+
+func (s *StoreRequestGorm) DeleteRequest(ctx context.Context, id uint) error {
+	return s.db.WithContext(ctx).Unscoped().Delete(&GameRequest{}, id).Error
+}
@@ -17,6 +17,8 @@ func (g *Game) ToProto() *v1.Game {
 		Meta:          g.Meta.ToProto(),
 		DownloadState: g.Download.ToProto(),
 		Source:        g.Source.ToProto(),
+		RequestedBy:   uint64(g.RequestedBy),
+		Visibility:    g.Visibility.String(),
 	}
 }
 
@@ -39,3 +41,35 @@ func (g *Game) FromProto(rpcGame *v1.Game) {
 	g.Download = *down
 	g.Source = *src
 }
+
+func (p *Playtime) ToProto() *v1.Playtime {
+	return &v1.Playtime{
+		GameId:     uint64(p.GameId),
+		Seconds:    p.Seconds,
+		Sessions:   int64(p.Sessions),
+		LastPlayed: p.LastPlayed.Format(time.RFC3339),
+	}
+}
+
+func (u *UserGame) ToProto() *v1.CollectionEntry {
+	return &v1.CollectionEntry{
+		GameId: uint64(u.GameId),
+		Owned:  u.Owned,
+		Hidden: u.Hidden,
+	}
+}
+
+func (r *GameRequest) ToProto() *v1.GameRequest {
+	return &v1.GameRequest{
+		Id:          uint64(r.ID),
+		Meta:        r.Meta.ToProto(),
+		RequestedBy: uint64(r.RequestedBy),
+		Note:        r.Note,
+		Status:      r.Status.String(),
+		ReviewedBy:  uint64(r.ReviewedBy),
+		Reason:      r.Reason,
+		GameId:      uint64(r.GameId),
+		CreatedAt:   r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   r.UpdatedAt.Format(time.RFC3339),
+	}
+}
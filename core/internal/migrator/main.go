@@ -18,9 +18,15 @@ func main() {
 		Load(
 			&library.Game{},
 			&library.FolderManifest{},
+			&library.Playtime{},
+			&library.UserGame{},
+			&library.GameAccess{},
+			&library.GameRequest{},
 			&services_manager.ServiceConfig{},
 			&user.User{},
+			&user.RolePermissions{},
 			&auth.Session{},
+			&auth.ApiToken{},
 		)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to load gorm schema: %v\n", err)
@@ -25,7 +25,12 @@ func NewHandler(srv *Service) (string, http.Handler) {
 }
 
 func (h *Handler) SearchIndexers(ctx context.Context, req *connect.Request[v1.SearchIndexersRequest]) (*connect.Response[v1.SearchIndexersResponse], error) {
-	search, err := h.srv.GetIndexerResults(req.Msg.Q.Indexer, req.Msg.Q.Query)
+	search, total, err := h.srv.GetIndexerResults(
+		req.Msg.Q.Indexer,
+		req.Msg.Q.Query,
+		uint(req.Msg.Offset),
+		uint(req.Msg.Limit),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +41,7 @@ func (h *Handler) SearchIndexers(ctx context.Context, req *connect.Request[v1.Se
 
 	return connect.NewResponse(&v1.SearchIndexersResponse{
 		Results: res,
+		Total:   uint32(total),
 	}), nil
 }
 
@@ -5,6 +5,7 @@ import (
 	indexerTypes "github.com/ra341/glacier/internal/indexer/types"
 	"github.com/ra341/glacier/internal/metadata"
 	metaTypes "github.com/ra341/glacier/internal/metadata/types"
+	"github.com/ra341/glacier/pkg/listutils"
 )
 
 type Service struct {
@@ -27,10 +28,16 @@ func (s *Service) GetMetadataResults(name string, query string) ([]metaTypes.Met
 	return s.metaSrv.Match(name, query)
 }
 
-func (s *Service) GetIndexerResults(name string, query string) ([]indexerTypes.Source, error) {
+// GetIndexerResults returns a page of the ranked results and the total number of results
+func (s *Service) GetIndexerResults(name string, query string, offset, limit uint) ([]indexerTypes.Source, int, error) {
 	if query == "" {
-		return []indexerTypes.Source{}, nil
+		return []indexerTypes.Source{}, 0, nil
 	}
 
-	return s.indexer.Search(name, query)
+	results, err := s.indexer.Search(name, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return listutils.Paginate(results, offset, limit), len(results), nil
 }
@@ -3,6 +3,8 @@ package services_manager
 import (
 	"fmt"
 
+	"github.com/ra341/glacier/internal/downloader/clients/ddl"
+	"github.com/ra341/glacier/internal/downloader/clients/qbittorrent"
 	"github.com/ra341/glacier/internal/downloader/clients/transmission"
 	downloaderTypes "github.com/ra341/glacier/internal/downloader/types"
 	"github.com/ra341/glacier/pkg/mapsct"
@@ -37,6 +39,14 @@ func NewDownloaderMap(store Store) ServiceConfigMap[downloaderTypes.Downloader]
 				InitFn: transmission.New,
 				Config: transmission.Config{},
 			},
+			downloaderTypes.ClientQBittorrent: {
+				InitFn: qbittorrent.New,
+				Config: qbittorrent.Config{},
+			},
+			downloaderTypes.ClientHTTP: {
+				InitFn: ddl.New,
+				Config: ddl.Config{},
+			},
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ra341/glacier/internal/indexer/indexers/hydra"
+	"github.com/ra341/glacier/internal/indexer/indexers/torznab"
 	indexTypes "github.com/ra341/glacier/internal/indexer/types"
 	"github.com/ra341/glacier/pkg/mapsct"
 	"github.com/ra341/glacier/pkg/syncmap"
@@ -24,6 +25,10 @@ func NewIndexerMap(store Store) ServiceConfigMap[indexTypes.Indexer] {
 				InitFn: hydra.New,
 				Config: hydra.Config{},
 			},
+			indexTypes.IndexerTorznab: {
+				InitFn: torznab.New,
+				Config: torznab.Config{},
+			},
 		},
 	}
 }
@@ -33,12 +33,7 @@ func (h *Handler) Self(ctx context.Context, c *connect.Request[v1.SelfRequest])
 }
 
 func (h *Handler) List(ctx context.Context, req *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error) {
-	actionUser, err := GetUserCtx(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	list, err := h.srv.List(req.Msg.Query, actionUser)
+	list, err := h.srv.List(ctx, req.Msg.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -53,21 +48,19 @@ func (h *Handler) List(ctx context.Context, req *connect.Request[v1.ListRequest]
 }
 
 func (h *Handler) ListRoles(ctx context.Context, c *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error) {
-	res := listutils.ToMap(RoleStrings(), func(t string) *v1.Role {
+	res := listutils.ToMap(RoleValues(), func(t Role) *v1.Role {
 		return &v1.Role{
-			Name: t,
+			Name: t.String(),
+			Permissions: listutils.ToMap(h.srv.Permissions(t), func(p Permission) string {
+				return string(p)
+			}),
 		}
 	})
 	return connect.NewResponse(&v1.ListRolesResponse{Roles: res}), nil
 }
 
 func (h *Handler) Delete(ctx context.Context, req *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error) {
-	actionBy, err := GetUserCtx(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	err = h.srv.Delete(uint(req.Msg.Id), actionBy)
+	err := h.srv.Delete(ctx, uint(req.Msg.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -76,22 +69,17 @@ func (h *Handler) Delete(ctx context.Context, req *connect.Request[v1.DeleteRequ
 }
 
 func (h *Handler) New(ctx context.Context, req *connect.Request[v1.NewRequest]) (*connect.Response[v1.NewResponse], error) {
-	actionBy, err := GetUserCtx(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var editUser User
-	err = editUser.FromProto(req.Msg.User)
+	err := editUser.FromProto(req.Msg.User)
 	if err != nil {
 		return nil, err
 	}
 
 	err = h.srv.New(
+		ctx,
 		editUser.Username,
 		editUser.EncryptedPassword,
 		editUser.Role,
-		actionBy,
 	)
 	if err != nil {
 		return nil, err
@@ -101,21 +89,42 @@ func (h *Handler) New(ctx context.Context, req *connect.Request[v1.NewRequest])
 }
 
 func (h *Handler) Edit(ctx context.Context, req *connect.Request[v1.EditRequest]) (*connect.Response[v1.EditResponse], error) {
-	actionBy, err := GetUserCtx(ctx)
+	var editUser User
+	err := editUser.FromProto(req.Msg.User)
 	if err != nil {
 		return nil, err
 	}
 
-	var editUser User
-	err = editUser.FromProto(req.Msg.User)
+	err = h.srv.Edit(ctx, &editUser)
 	if err != nil {
 		return nil, err
 	}
 
-	err = h.srv.Edit(&editUser, actionBy)
+	return connect.NewResponse(&v1.EditResponse{}), nil
+}
+
+func (h *Handler) ListPermissions(ctx context.Context, req *connect.Request[v1.ListPermissionsRequest]) (*connect.Response[v1.ListPermissionsResponse], error) {
+	res := listutils.ToMap(AllPermissions, func(t Permission) string {
+		return string(t)
+	})
+	return connect.NewResponse(&v1.ListPermissionsResponse{Permissions: res}), nil
+}
+
+func (h *Handler) SetRolePermissions(ctx context.Context, req *connect.Request[v1.SetRolePermissionsRequest]) (*connect.Response[v1.SetRolePermissionsResponse], error) {
+	role, err := RoleString(req.Msg.Role)
 	if err != nil {
 		return nil, err
 	}
 
-	return connect.NewResponse(&v1.EditResponse{}), nil
+	perms, err := listutils.ToMapErr(req.Msg.Permissions, PermissionString)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.srv.SetRolePermissions(ctx, role, perms)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetRolePermissionsResponse{}), nil
 }
@@ -4,40 +4,35 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"connectrpc.com/connect"
-	"github.com/ra341/glacier/generated/service_config/v1/v1connect"
 	"github.com/ra341/glacier/shared/api"
 )
 
-func AdminMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == v1connect.ServiceConfigServiceGetActiveServiceProcedure {
+// PermissionMiddleware only lets users with the permission through,
+// the public paths are open to every logged-in user
+func PermissionMiddleware(perm Permission, public ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(public, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			_, err := Authorize(r.Context(), perm)
+			if err != nil {
+				api.WriteErr(w,
+					http.StatusForbidden,
+					connect.CodePermissionDenied,
+					err.Error(),
+				)
+				return
+			}
+
 			next.ServeHTTP(w, r)
-			return
-		}
-
-		user, err := GetUserCtx(r.Context())
-		if err != nil {
-			api.WriteErr(w,
-				http.StatusBadRequest,
-				connect.CodeUnauthenticated,
-				"user info not found in context",
-			)
-			return
-		}
-
-		if user.Role > Magos {
-			api.WriteErr(w,
-				http.StatusBadRequest,
-				connect.CodeUnauthenticated,
-				"insufficient permission to access this resource",
-			)
-			return
-		}
-
-		next.ServeHTTP(w, r)
-	})
+		})
+	}
 }
 
 const CtxKeyUser = "user"
@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+// Permission is a named action a role can be allowed to do
+type Permission string
+
+const (
+	// PermLibraryAdd adds games and starts their download on the server
+	PermLibraryAdd Permission = "library:add"
+	// PermLibraryRequest asks for a game to be added, an admin approves or denies it
+	PermLibraryRequest Permission = "library:request"
+	// PermLibraryDelete deletes games and their files
+	PermLibraryDelete Permission = "library:delete"
+	// PermLibraryManage edits any game, sees every private game and changes who can see it
+	PermLibraryManage Permission = "library:manage"
+	// PermServicesManage configures the indexer, metadata and downloader services
+	PermServicesManage Permission = "services:manage"
+	// PermUsersManage lists, creates, edits and deletes other users and changes role permissions
+	PermUsersManage Permission = "users:manage"
+	// PermDownloadFrost downloads game files to frost
+	PermDownloadFrost Permission = "download:frost"
+	// PermRequestsManage sees every game request and approves or denies them
+	PermRequestsManage Permission = "requests:manage"
+	// PermAccountManage edits the own account and password and manages its sessions and api tokens,
+	// every logged-in user has it, api tokens only when it is one of their scopes
+	PermAccountManage Permission = "account:manage"
+)
+
+var AllPermissions = []Permission{
+	PermLibraryAdd,
+	PermLibraryRequest,
+	PermLibraryDelete,
+	PermLibraryManage,
+	PermServicesManage,
+	PermUsersManage,
+	PermDownloadFrost,
+	PermRequestsManage,
+	PermAccountManage,
+}
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+func PermissionString(s string) (Permission, error) {
+	perm := Permission(s)
+	if !slices.Contains(AllPermissions, perm) {
+		return "", fmt.Errorf("%s is not a permission", s)
+	}
+	return perm, nil
+}
+
+// DefaultPermissions are used until the permissions of a role are changed,
+// Omnissiah always has every permission
+func DefaultPermissions(role Role) []Permission {
+	switch role {
+	case Omnissiah, Magos:
+		return slices.Clone(AllPermissions)
+	default:
+		// games are requested and added once an admin approves
+		return []Permission{PermLibraryRequest, PermDownloadFrost}
+	}
+}
+
+// RolePermissions replaces the default permissions of a role
+type RolePermissions struct {
+	gorm.Model
+
+	Role        Role         `gorm:"uniqueIndex"`
+	Permissions []Permission `gorm:"serializer:json"`
+}
+
+func (u *User) Can(perm Permission) bool {
+	return slices.Contains(u.Permissions, perm)
+}
+
+// Authorize gets the user in the context and checks it has every permission,
+// every permission check of the api goes through here
+func Authorize(ctx context.Context, perms ...Permission) (*User, error) {
+	u, err := GetUserCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, perm := range perms {
+		if !u.Can(perm) {
+			return nil, fmt.Errorf("%w: %s is needed", ErrPermissionDenied, perm)
+		}
+	}
+	return u, nil
+}
@@ -1,8 +1,11 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
@@ -10,11 +13,16 @@ import (
 
 type Service struct {
 	store Store
+
+	rolesMu sync.RWMutex
+	// roles with permissions that are not the default
+	roles map[Role][]Permission
 }
 
 func NewService(store Store) *Service {
 	s := &Service{store: store}
 	s.Init()
+	s.loadRoles()
 	return s
 }
 
@@ -38,6 +46,78 @@ func (s *Service) Init() {
 	}
 }
 
+func (s *Service) loadRoles() {
+	saved, err := s.store.ListRolePermissions()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load role permissions")
+	}
+
+	roles := make(map[Role][]Permission, len(saved))
+	for _, r := range saved {
+		roles[r.Role] = r.Permissions
+	}
+
+	s.rolesMu.Lock()
+	s.roles = roles
+	s.rolesMu.Unlock()
+}
+
+// Permissions of the role
+func (s *Service) Permissions(role Role) []Permission {
+	if role == Omnissiah {
+		return DefaultPermissions(role)
+	}
+
+	s.rolesMu.RLock()
+	defer s.rolesMu.RUnlock()
+	perms, ok := s.roles[role]
+	if !ok {
+		return DefaultPermissions(role)
+	}
+	return slices.Clone(perms)
+}
+
+// SetRolePermissions replaces the permissions of the role,
+// a user can only give out permissions they have and Omnissiah cannot be changed
+func (s *Service) SetRolePermissions(ctx context.Context, role Role, perms []Permission) error {
+	editor, err := Authorize(ctx, PermUsersManage)
+	if err != nil {
+		return err
+	}
+	if role == Omnissiah {
+		return fmt.Errorf("permissions of %s cannot be changed", Omnissiah)
+	}
+	if role < editor.Role {
+		return fmt.Errorf("insufficient privileges to edit role: %s", role.String())
+	}
+	// only permissions the editor has can be given out
+	_, err = Authorize(ctx, perms...)
+	if err != nil {
+		return err
+	}
+
+	perms = slices.Compact(slices.Sorted(slices.Values(perms)))
+	err = s.store.SetRolePermissions(&RolePermissions{Role: role, Permissions: perms})
+	if err != nil {
+		return err
+	}
+
+	s.rolesMu.Lock()
+	s.roles[role] = perms
+	s.rolesMu.Unlock()
+	return nil
+}
+
+// WithPermissions sets the permissions of the user from its role,
+// account:manage is given to every user regardless of the role
+func (s *Service) WithPermissions(u *User) *User {
+	u.Permissions = s.Permissions(u.Role)
+	if !u.Can(PermAccountManage) {
+		u.Permissions = append(u.Permissions, PermAccountManage)
+	}
+	return u
+}
+
 func (s *Service) GetByUsername(username string) (User, error) {
 	return s.store.GetByUsername(username)
 }
@@ -50,35 +130,38 @@ func (s *Service) GetByID(id uint) (User, error) {
 	return s.store.GetByID(id)
 }
 
-func (s *Service) List(q string, user *User) ([]User, error) {
-	if user.Role > Magos {
-		return []User{}, fmt.Errorf("insufficient privileges to list users")
+func (s *Service) List(ctx context.Context, q string) ([]User, error) {
+	_, err := Authorize(ctx, PermUsersManage)
+	if err != nil {
+		return []User{}, err
 	}
 
 	return s.store.List(q)
 }
 
-func (s *Service) New(user, password string, role Role, createdBy *User) error {
-	finalRole := TechPriest
-	if createdBy != nil {
-		if createdBy.Role > Magos {
-			return fmt.Errorf("role %s does not have permission to create users", createdBy.Role)
-		}
-
-		// if requested role is higher privilege (lower number) than the creator
-		if role < createdBy.Role {
-			log.Warn().
-				Str("requested_role", role.String()).
-				Str("creator_role", createdBy.Role.String()).
-				Msg("Access denied: Cannot create a user with higher privileges than yourself")
-			return fmt.Errorf("insufficient privileges for role: %s", role.String())
-		}
+// New creates a user on behalf of the user in the context
+func (s *Service) New(ctx context.Context, user, password string, role Role) error {
+	createdBy, err := Authorize(ctx, PermUsersManage)
+	if err != nil {
+		return err
+	}
 
-		// Allow the assignment if it's equal or lower privilege (equal or higher number)
-		finalRole = role
+	// if requested role is higher privilege (lower number) than the creator
+	if role < createdBy.Role {
+		log.Warn().
+			Str("requested_role", role.String()).
+			Str("creator_role", createdBy.Role.String()).
+			Msg("Access denied: Cannot create a user with higher privileges than yourself")
+		return fmt.Errorf("insufficient privileges for role: %s", role.String())
 	}
 
-	return s.newRaw(0, user, password, finalRole)
+	// Allow the assignment if it's equal or lower privilege (equal or higher number)
+	return s.newRaw(0, user, password, role)
+}
+
+// Register creates a user with the lowest role, used for open registration
+func (s *Service) Register(user, password string) error {
+	return s.newRaw(0, user, password, TechPriest)
 }
 
 // registers a new user without role checks assumes all role is verified and trusted
@@ -102,15 +185,25 @@ func (s *Service) newRaw(userid uint, user string, password string, finalRole Ro
 	return err
 }
 
-func (s *Service) Edit(user *User, editorUser *User) (err error) {
-	if editorUser == nil {
-		return fmt.Errorf("editor user cannot be nil")
+func (s *Service) Edit(ctx context.Context, user *User) (err error) {
+	editorUser, err := GetUserCtx(ctx)
+	if err != nil {
+		return err
 	}
 
 	if user.ID == editorUser.ID && user.Role != editorUser.Role {
 		return fmt.Errorf("cannot change self role")
 	}
 
+	perm := PermAccountManage
+	if user.ID != editorUser.ID {
+		perm = PermUsersManage
+	}
+	_, err = Authorize(ctx, perm)
+	if err != nil {
+		return err
+	}
+
 	if user.Role < editorUser.Role {
 		return fmt.Errorf("insufficient privileges to edit role: %s", user.Role.String())
 	}
@@ -125,7 +218,11 @@ func (s *Service) Edit(user *User, editorUser *User) (err error) {
 	return s.store.Edit(user)
 }
 
-func (s *Service) Delete(id uint, deleteBy *User) error {
+func (s *Service) Delete(ctx context.Context, id uint) error {
+	deleteBy, err := Authorize(ctx, PermUsersManage)
+	if err != nil {
+		return err
+	}
 	if deleteBy.ID == id {
 		return fmt.Errorf("cannot delete self")
 	}
@@ -135,7 +232,7 @@ func (s *Service) Delete(id uint, deleteBy *User) error {
 		return err
 	}
 
-	if deleted.Role < deleteBy.Role || deleteBy.Role > Magos {
+	if deleted.Role < deleteBy.Role {
 		return fmt.Errorf("cannot delete user with higher permission")
 	}
 
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ra341/glacier/internal/database"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService(t *testing.T) *Service {
+	return NewService(NewStoreGorm(database.New(t.TempDir(), false)))
+}
+
+func withUser(u *User) context.Context {
+	return context.WithValue(context.Background(), CtxKeyUser, u)
+}
+
+func TestRolePermissions(t *testing.T) {
+	srv := newTestService(t)
+
+	admin, err := srv.GetByID(DefaultUserId)
+	require.NoError(t, err)
+	srv.WithPermissions(&admin)
+	require.ElementsMatch(t, AllPermissions, admin.Permissions)
+
+	magos := srv.WithPermissions(&User{Role: Magos})
+	priest := srv.WithPermissions(&User{Role: TechPriest})
+	require.False(t, priest.Can(PermLibraryDelete))
+	require.True(t, priest.Can(PermDownloadFrost))
+
+	// priests cannot change permissions
+	err = srv.SetRolePermissions(withUser(priest), TechPriest, []Permission{PermLibraryDelete})
+	require.ErrorIs(t, err, ErrPermissionDenied)
+
+	// omnissiah is fixed
+	err = srv.SetRolePermissions(withUser(&admin), Omnissiah, nil)
+	require.Error(t, err)
+
+	err = srv.SetRolePermissions(withUser(magos), TechPriest, []Permission{PermLibraryDelete, PermLibraryAdd})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []Permission{PermLibraryAdd, PermLibraryDelete}, srv.Permissions(TechPriest))
+
+	// only permissions the editor has can be given out
+	err = srv.SetRolePermissions(withUser(&admin), Magos, []Permission{PermUsersManage, PermLibraryAdd})
+	require.NoError(t, err)
+	magos = srv.WithPermissions(&User{Role: Magos})
+	err = srv.SetRolePermissions(withUser(magos), TechPriest, []Permission{PermLibraryDelete})
+	require.ErrorIs(t, err, ErrPermissionDenied)
+
+	// changes are saved
+	reloaded := NewService(srv.store)
+	require.ElementsMatch(t, []Permission{PermLibraryAdd, PermLibraryDelete}, reloaded.Permissions(TechPriest))
+	require.ElementsMatch(t, []Permission{PermUsersManage, PermLibraryAdd}, reloaded.Permissions(Magos))
+}
+
+func TestAuthorize(t *testing.T) {
+	_, err := Authorize(context.Background(), PermLibraryAdd)
+	require.Error(t, err)
+
+	u := &User{Role: TechPriest, Permissions: DefaultPermissions(TechPriest)}
+	ctx := withUser(u)
+
+	got, err := Authorize(ctx, PermLibraryRequest, PermDownloadFrost)
+	require.NoError(t, err)
+	require.Equal(t, u, got)
+
+	_, err = Authorize(ctx, PermLibraryRequest, PermLibraryManage)
+	require.ErrorIs(t, err, ErrPermissionDenied)
+}
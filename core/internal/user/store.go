@@ -13,6 +13,9 @@ type Store interface {
 	Edit(user *User) error
 	Delete(id uint) error
 	List(q string) ([]User, error)
+
+	ListRolePermissions() ([]RolePermissions, error)
+	SetRolePermissions(perms *RolePermissions) error
 }
 
 //go:generate go run github.com/dmarkham/enumer@latest -sql -type=Role -output=enum_user_role.go
@@ -34,4 +37,6 @@ type User struct {
 	EncryptedPassword string
 
 	Role Role
+	// permissions of the role, set when the user is loaded for a session
+	Permissions []Permission `gorm:"-"`
 }
@@ -1,6 +1,9 @@
 package user
 
-import "gorm.io/gorm"
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
 
 type StoreGorm struct {
 	db *gorm.DB
@@ -50,3 +53,19 @@ func (s *StoreGorm) List(q string) ([]User, error) {
 
 	return users, err
 }
+
+func (s *StoreGorm) ListRolePermissions() ([]RolePermissions, error) {
+	var perms []RolePermissions
+	err := s.db.Find(&perms).Error
+	return perms, err
+}
+
+func (s *StoreGorm) SetRolePermissions(perms *RolePermissions) error {
+	return s.db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "role"}},
+			DoUpdates: clause.AssignmentColumns([]string{"permissions", "updated_at"}),
+		}).
+		Create(perms).
+		Error
+}
@@ -1,12 +1,18 @@
 package user
 
-import v1 "github.com/ra341/glacier/generated/user/v1"
+import (
+	v1 "github.com/ra341/glacier/generated/user/v1"
+	"github.com/ra341/glacier/pkg/listutils"
+)
 
 func (u *User) ToProto() *v1.User {
 	return &v1.User{
 		Id:       uint64(u.ID),
 		Username: u.Username,
 		Role:     u.Role.String(),
+		Permissions: listutils.ToMap(u.Permissions, func(t Permission) string {
+			return string(t)
+		}),
 		//Password: u.EncryptedPassword, dont send the password
 	}
 }
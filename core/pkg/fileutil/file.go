@@ -7,6 +7,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 )
@@ -56,6 +57,33 @@ func Close(rw io.Closer) {
 	}
 }
 
+// RemoveAllWithin removes path and its children, only if path is nested inside root.
+// guards against wiping root itself when a path was never populated
+func RemoveAllWithin(root, path string) error {
+	if path == "" {
+		return nil
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(absRoot, absPath)
+	if err != nil {
+		return err
+	}
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("refusing to remove %s, it is not inside %s", absPath, absRoot)
+	}
+
+	return os.RemoveAll(absPath)
+}
+
 func CopyFolder(sourceDir, targetDir string, ignoreDir string) error {
 	return walkFolder(sourceDir, targetDir, ignoreDir, copyFile)
 }
@@ -0,0 +1,25 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveAllWithin(t *testing.T) {
+	root := t.TempDir()
+	game := filepath.Join(root, "game")
+	require.NoError(t, os.MkdirAll(filepath.Join(game, "data"), os.ModePerm))
+
+	require.Error(t, RemoveAllWithin(root, root))
+	require.Error(t, RemoveAllWithin(root, filepath.Dir(root)))
+	require.Error(t, RemoveAllWithin(game, filepath.Join(game, "..", "other")))
+	require.DirExists(t, game)
+
+	require.NoError(t, RemoveAllWithin(root, ""))
+	require.NoError(t, RemoveAllWithin(root, game))
+	require.NoDirExists(t, game)
+	require.DirExists(t, root)
+}
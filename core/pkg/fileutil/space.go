@@ -0,0 +1,116 @@
+package fileutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/dustin/go-humanize"
+)
+
+var ErrNotEnoughSpace = errors.New("not enough disk space")
+
+// FreeSpaceFn returns the bytes available to the user on the disk of path
+type FreeSpaceFn func(path string) (uint64, error)
+
+// SpaceReserver tracks space promised to downloads that have not written it yet,
+// so concurrent downloads do not all pass a check against the same free space.
+// Reservations on every disk are counted against each check.
+type SpaceReserver struct {
+	mu       sync.Mutex
+	reserved map[string]uint64
+	free     FreeSpaceFn
+}
+
+func NewSpaceReserver(free FreeSpaceFn) *SpaceReserver {
+	if free == nil {
+		free = FreeSpace
+	}
+	return &SpaceReserver{
+		reserved: map[string]uint64{},
+		free:     free,
+	}
+}
+
+// Reserve sets the space held by key if path has enough free space left,
+// reserving again under the same key replaces its previous reservation
+func (r *SpaceReserver) Reserve(key, path string, size uint64) error {
+	free, err := r.free(existingDir(path))
+	if err != nil {
+		return fmt.Errorf("could not check free space of %s: %w", path, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var others uint64
+	for k, reserved := range r.reserved {
+		if k != key {
+			others += reserved
+		}
+	}
+
+	available := uint64(0)
+	if free > others {
+		available = free - others
+	}
+	if size > available {
+		return fmt.Errorf(
+			"%w: %s needs %s, %s free",
+			ErrNotEnoughSpace, path, humanize.IBytes(size), humanize.IBytes(available),
+		)
+	}
+
+	r.reserved[key] = size
+	return nil
+}
+
+// Consume lowers the reservation of key once size bytes of it are written to disk
+func (r *SpaceReserver) Consume(key string, size uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reserved, ok := r.reserved[key]
+	if !ok {
+		return
+	}
+	r.reserved[key] = reserved - min(reserved, size)
+}
+
+// Release frees the space reserved by key, releasing an unknown key does nothing
+func (r *SpaceReserver) Release(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.reserved, key)
+}
+
+// Reserved is the total space held by all reservations
+func (r *SpaceReserver) Reserved() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var total uint64
+	for _, reserved := range r.reserved {
+		total += reserved
+	}
+	return total
+}
+
+// existingDir walks up to the closest dir that exists,
+// downloads can target folders that are not created yet
+func existingDir(path string) string {
+	path = filepath.Clean(path)
+	for {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+
+		parent := filepath.Dir(path)
+		if parent == path {
+			return path
+		}
+		path = parent
+	}
+}
@@ -0,0 +1,43 @@
+package fileutil
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceReserver(t *testing.T) {
+	root := t.TempDir()
+	var checked string
+	reserver := NewSpaceReserver(func(path string) (uint64, error) {
+		checked = path
+		return 100, nil
+	})
+
+	// folders that do not exist yet are checked on their closest parent
+	require.NoError(t, reserver.Reserve("a", filepath.Join(root, "game", "data"), 60))
+	require.Equal(t, root, checked)
+
+	// the first reservation is held against the second
+	err := reserver.Reserve("b", root, 50)
+	require.ErrorIs(t, err, ErrNotEnoughSpace)
+	require.NoError(t, reserver.Reserve("b", root, 40))
+
+	// reserving again replaces the old reservation of the key
+	require.NoError(t, reserver.Reserve("a", root, 50))
+	require.Equal(t, uint64(90), reserver.Reserved())
+
+	reserver.Consume("a", 30)
+	reserver.Consume("b", 100)
+	require.Equal(t, uint64(20), reserver.Reserved())
+
+	reserver.Release("a")
+	require.Zero(t, reserver.Reserved())
+}
+
+func TestFreeSpace(t *testing.T) {
+	free, err := FreeSpace(t.TempDir())
+	require.NoError(t, err)
+	require.NotZero(t, free)
+}
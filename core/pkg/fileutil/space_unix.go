@@ -0,0 +1,17 @@
+//go:build unix
+
+package fileutil
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// FreeSpace returns the bytes available to the user on the disk of path
+func FreeSpace(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	err := unix.Statfs(path, &stat)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
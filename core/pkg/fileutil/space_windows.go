@@ -0,0 +1,22 @@
+//go:build windows
+
+package fileutil
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+// FreeSpace returns the bytes available to the user on the disk of path
+func FreeSpace(path string) (uint64, error) {
+	dir, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var available, total, free uint64
+	err = windows.GetDiskFreeSpaceEx(dir, &available, &total, &free)
+	if err != nil {
+		return 0, err
+	}
+	return available, nil
+}
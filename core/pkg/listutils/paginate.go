@@ -0,0 +1,15 @@
+package listutils
+
+// Paginate returns the page of input starting at offset,
+// a limit of 0 returns everything after offset
+func Paginate[T any](input []T, offset, limit uint) []T {
+	if offset >= uint(len(input)) {
+		return []T{}
+	}
+
+	input = input[offset:]
+	if limit > 0 && limit < uint(len(input)) {
+		input = input[:limit]
+	}
+	return input
+}
@@ -0,0 +1,82 @@
+package pubsub
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Broker fans out published values to every subscriber,
+// a subscriber that falls behind misses values instead of blocking the publisher
+type Broker[T any] struct {
+	mu     sync.RWMutex
+	nextId uint64
+	subs   map[uint64]*Subscription[T]
+}
+
+// Subscription receives published values on C until it is closed
+type Subscription[T any] struct {
+	C <-chan T
+
+	ch      chan T
+	dropped atomic.Uint64
+	close   func()
+}
+
+// Dropped returns the number of values missed because C was full since the last call,
+// subscribers should reload their state when it is not 0
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// Close unsubscribes and closes C
+func (s *Subscription[T]) Close() {
+	s.close()
+}
+
+// Subscribe returns a subscription with C buffered with size
+func (b *Broker[T]) Subscribe(size int) *Subscription[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subs == nil {
+		b.subs = map[uint64]*Subscription[T]{}
+	}
+
+	id := b.nextId
+	b.nextId++
+	ch := make(chan T, size)
+	sub := &Subscription[T]{C: ch, ch: ch}
+	b.subs[id] = sub
+
+	var once sync.Once
+	sub.close = func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subs, id)
+			close(ch)
+		})
+	}
+	return sub
+}
+
+func (b *Broker[T]) Publish(val T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subs {
+		select {
+		case sub.ch <- val:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// Len is the number of subscribers
+func (b *Broker[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs)
+}
@@ -0,0 +1,25 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDropped(t *testing.T) {
+	var b Broker[int]
+	sub := b.Subscribe(1)
+
+	b.Publish(1)
+	b.Publish(2)
+	b.Publish(3)
+	require.Equal(t, 1, <-sub.C)
+	require.Equal(t, uint64(2), sub.Dropped())
+	require.Equal(t, uint64(0), sub.Dropped())
+
+	sub.Close()
+	sub.Close()
+	require.Equal(t, 0, b.Len())
+	_, ok := <-sub.C
+	require.False(t, ok)
+}